@@ -0,0 +1,20 @@
+package pipeline
+
+// СТАДИЯ 1: Генератор чисел 1..n
+// Канал нужно закрыть, когда числа закончатся.
+func GenerateNumbers(n int) <-chan int {
+	// TODO: создай канал, запусти горутину и верни канал
+	return nil
+}
+
+// СТАДИЯ 2: Обработчик (Квадрат)
+func SquareNumbers(in <-chan int) <-chan int {
+	// TODO
+	return nil
+}
+
+// СТАДИЯ 3: Умножитель
+func MultiplyByTwo(in <-chan int) <-chan int {
+	// TODO
+	return nil
+}
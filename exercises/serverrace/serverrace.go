@@ -0,0 +1,15 @@
+package serverrace
+
+import (
+	"errors"
+	"time"
+)
+
+// Ошибка: ни один сервер не ответил вовремя
+var ErrTimeout = errors.New("время вышло")
+
+// Возвращает ответ самого быстрого сервера или ErrTimeout
+func Race(timeout time.Duration, servers ...func() string) (string, error) {
+	// TODO: горутина на каждый сервер + select с time.After
+	return "", nil
+}
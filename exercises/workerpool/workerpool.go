@@ -0,0 +1,20 @@
+package workerpool
+
+// Заказ (сырая задача)
+type Order struct {
+	ID        int
+	PizzaName string
+}
+
+// Пицца (готовый результат)
+type Pizza struct {
+	OrderID   int
+	PizzaName string
+	ChefID    int // Кто приготовил
+}
+
+// Пул из numChefs поваров, которые готовят все заказы через cook
+func RunKitchen(orders []Order, numChefs int, cook func(chefID int, order Order) Pizza) []Pizza {
+	// TODO: каналы jobs/results, WaitGroup и numChefs горутин
+	return nil
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/yourusername/go-learning-tracker/tracker/notify"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 🐙 GITHUB ACTIONS: сводка задания, аннотации и outputs шага
+// Включается само: переменные GITHUB_* задаёт раннер, локально их нет
+//
+//	GITHUB_STEP_SUMMARY — Markdown-сводка на странице запуска (шаблон summary)
+//	GITHUB_ACTIONS=true — ::notice за новые темы, ::warning за штраф и integrity
+//	GITHUB_OUTPUT       — steps.<id>.outputs.level, xp, league, status и exit_code
+
+// 📊 Итоги запуска ученика в Actions
+func (tr *tracker) reportToActions(report ReportData) {
+	tr.writeJobSummary(tr.renderReport("summary", report))
+	tr.annotateRun(report)
+}
+
+// 📝 Добавление Markdown в сводку задания
+func (tr *tracker) writeJobSummary(markdown string) {
+	path := os.Getenv("GITHUB_STEP_SUMMARY")
+	if path == "" {
+		return
+	}
+	if err := appendFile(path, notify.Apply(markdown, notify.Markdown)+"\n"); err != nil {
+		fmt.Println(tr.T("file.write_error", path, err))
+		slog.Error("file not written", "path", path, "err", err)
+	}
+}
+
+// 📌 Аннотации: новые темы, штраф за пропуски и подозрения integrity
+func (tr *tracker) annotateRun(report ReportData) {
+	if os.Getenv("GITHUB_ACTIONS") != "true" {
+		return
+	}
+	username := report.Stats.Username
+
+	for _, level := range report.Levels {
+		for _, topic := range level.Topics {
+			if isNewTopic(report, topic.Name) {
+				fmt.Println(workflowCommand("notice", tr.T("actions.topic_title", username), tr.T("run.new_topic", topic.Name, topic.XPReward)))
+			}
+		}
+	}
+
+	if report.XP.Penalty > 0 {
+		fmt.Println(workflowCommand("warning", tr.T("actions.penalty_title", username), tr.T("run.penalty", report.XP.Penalty, report.Stats.PenaltyDays)))
+	}
+
+	for _, flag := range report.IntegrityFlags {
+		fmt.Println(workflowCommand("warning", tr.T("actions.integrity_title", username), tr.T("integrity."+flag.Kind, flag.Commit, flag.Value)))
+	}
+}
+
+func isNewTopic(report ReportData, name string) bool {
+	for _, newTopic := range report.XP.NewTopics {
+		if newTopic == name {
+			return true
+		}
+	}
+	return false
+}
+
+// 📤 Outputs шага для следующих шагов workflow
+func (tr *tracker) setStepOutputs(stats store.UserStats) {
+	path := os.Getenv("GITHUB_OUTPUT")
+	if path == "" {
+		return
+	}
+
+	outputs := fmt.Sprintf("level=%d\nxp=%d\nleague=%s\n", stats.Level, stats.TotalXP, stats.League)
+	if err := appendFile(path, outputs); err != nil {
+		fmt.Println(tr.T("file.write_error", path, err))
+		slog.Error("file not written", "path", path, "err", err)
+	}
+}
+
+// 🚦 Итог запуска в outputs: status (ok, partial_delivery, ...) и exit_code
+// Следующие шаги workflow решают по ним, коммитить ли статистику
+func (tr *tracker) setRunOutputs(result RunResult) {
+	path := os.Getenv("GITHUB_OUTPUT")
+	if path == "" {
+		return
+	}
+
+	outputs := fmt.Sprintf("status=%s\nexit_code=%d\n", result.Status, result.ExitCode)
+	if err := appendFile(path, outputs); err != nil {
+		fmt.Println(tr.T("file.write_error", path, err))
+		slog.Error("file not written", "path", path, "err", err)
+	}
+}
+
+// 🔣 Команда workflow: ::<команда> title=<заголовок>::<сообщение>
+// Переводы строк и % экранируются, в заголовке — ещё : и ,
+func workflowCommand(command, title, message string) string {
+	data := strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A")
+	property := strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C")
+	return fmt.Sprintf("::%s title=%s::%s", command, property.Replace(title), data.Replace(message))
+}
+
+// 📎 Дозапись в файл раннера (сводка и outputs копятся за все шаги)
+func appendFile(path, text string) error {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.WriteString(text); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+func TestWorkflowCommand(t *testing.T) {
+	got := workflowCommand("warning", "Штраф: alice, bob", "100% пропуск\nвторая строка")
+	want := "::warning title=Штраф%3A alice%2C bob::100%25 пропуск%0Aвторая строка"
+	if got != want {
+		t.Errorf("got  %q\nwant %q", got, want)
+	}
+}
+
+func TestJobSummaryGolden(t *testing.T) {
+	tr, report := trackedReport(t)
+
+	path := filepath.Join(t.TempDir(), "summary.md")
+	t.Setenv("GITHUB_STEP_SUMMARY", path)
+	tr.writeJobSummary(tr.renderReport("summary", report))
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "summary", string(data))
+}
+
+// 📎 Outputs дописываются к тому, что оставили прошлые шаги
+func TestSetStepOutputs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "output")
+	os.WriteFile(path, []byte("previous=1\n"), 0644)
+	t.Setenv("GITHUB_OUTPUT", path)
+
+	tr := newTestTracker(t, "2026-03-01", nil)
+	tr.setStepOutputs(store.UserStats{Level: 3, TotalXP: 1250, League: "🥈 Silver"})
+
+	data, _ := os.ReadFile(path)
+	want := "previous=1\nlevel=3\nxp=1250\nleague=🥈 Silver\n"
+	if string(data) != want {
+		t.Errorf("GITHUB_OUTPUT = %q, want %q", data, want)
+	}
+}
+
+func TestActionsDisabledLocally(t *testing.T) {
+	t.Setenv("GITHUB_STEP_SUMMARY", "")
+	t.Setenv("GITHUB_OUTPUT", "")
+
+	// Без переменных раннера ничего не пишется (и не падает)
+	tr := newTestTracker(t, "2026-03-01", nil)
+	tr.writeJobSummary("## test")
+	tr.setStepOutputs(store.UserStats{Level: 1})
+}
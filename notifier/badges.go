@@ -0,0 +1,175 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"log/slog"
+	"path"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yourusername/go-learning-tracker/tracker/analyzer"
+	"github.com/yourusername/go-learning-tracker/tracker/progress"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 🎨 Каталог со сгенерированными SVG-badges
+const badgesDir = "badges"
+
+// 🎨 Режим badges: local (свои SVG в badges/) или shields (img.shields.io)
+func (tr *tracker) badgeMode() string {
+	return tr.config.BadgeMode
+}
+
+// 🌐 Badges через img.shields.io (старый режим)
+func (tr *tracker) shieldsBadges(stats store.UserStats, percent float64) []string {
+	return []string{
+		fmt.Sprintf("![Level](https://img.shields.io/badge/Level-%d-blue)", stats.Level),
+		fmt.Sprintf("![Progress](https://img.shields.io/badge/Progress-%.0f%%25-brightgreen)", percent),
+		fmt.Sprintf("![Streak](https://img.shields.io/badge/Streak-%d_days-orange)", stats.CurrentStreak),
+		fmt.Sprintf("![XP](https://img.shields.io/badge/XP-%d-purple)", stats.TotalXP),
+		fmt.Sprintf("![League](https://img.shields.io/badge/League-%s-gold)", strings.ReplaceAll(stats.League, " ", "_")),
+		fmt.Sprintf("![Next level](https://img.shields.io/badge/Next_level-%s-blue)", strings.ReplaceAll(tr.nextLevelValue(stats), " ", "_")),
+	}
+}
+
+// ⏭️ Значение badge следующего уровня: "420 XP → 4" или "max"
+func (tr *tracker) nextLevelValue(stats store.UserStats) string {
+	next := tr.levelProgress(stats)
+	if next.Next == 0 {
+		return tr.T("badge.max_level")
+	}
+	return tr.T("badge.next_level_value", next.XPToNext, next.Next)
+}
+
+// 🖼️ Свои SVG-badges: пишем файлы в dir и возвращаем ссылки для README
+// Ошибки записи не прерывают остальные badges и возвращаются вместе
+func (tr *tracker) writeLocalBadges(dir string, stats store.UserStats, percent float64) ([]string, error) {
+	if err := tr.fs.MkdirAll(dir, 0755); err != nil {
+		fmt.Println(tr.T("badges.mkdir_error", dir, err))
+		slog.Error("directory not created", "path", dir, "err", err)
+		return tr.shieldsBadges(stats, percent), err
+	}
+
+	league := progress.LeagueTitle(stats.League)
+	badges := []struct {
+		alt  string
+		file string
+		svg  string
+	}{
+		{"Level", "level.svg", renderBadge(tr.T("badge.level"), fmt.Sprint(stats.Level), "#007ec6")},
+		{"Progress", "progress.svg", renderBadge(tr.T("badge.progress"), fmt.Sprintf("%.0f%%", percent), "#4c1")},
+		{"Streak", "streak.svg", renderBadge(tr.T("badge.streak"), tr.T("badge.streak_days", stats.CurrentStreak), "#fe7d37")},
+		{"XP", "xp.svg", renderBadge(tr.T("badge.xp"), fmt.Sprint(stats.TotalXP), "#9f45b0")},
+		{"League", "league.svg", renderBadge(tr.T("badge.league"), league, leagueColor(league))},
+		{"Next level", "next-level.svg", renderBadge(tr.T("badge.next_level"), tr.nextLevelValue(stats), "#007ec6")},
+		{"Topics", "topics.svg", tr.renderTopicsBadge(tr.syllabus)},
+	}
+
+	var lines []string
+	var errs []error
+	for _, badge := range badges {
+		file := path.Join(dir, badge.file)
+		if err := tr.fs.WriteFile(file, []byte(badge.svg), 0644); err != nil {
+			fmt.Println(tr.T("file.write_error", file, err))
+			slog.Error("file not written", "path", file, "err", err)
+			errs = append(errs, err)
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("![%s](%s)", badge.alt, file))
+	}
+
+	return lines, errors.Join(errs...)
+}
+
+// 🎨 Цвет лиги
+func leagueColor(league string) string {
+	switch league {
+	case "Diamond":
+		return "#1fb6d6"
+	case "Gold":
+		return "#dfb317"
+	case "Silver":
+		return "#9f9f9f"
+	}
+	return "#a05a2c"
+}
+
+// 📏 Примерная ширина текста (Verdana 11px)
+func textWidth(text string) int {
+	return utf8.RuneCountInString(text)*7 + 10
+}
+
+// 🏷️ Badge в стиле shields.io "flat"
+func renderBadge(label, value, color string) string {
+	labelWidth := textWidth(label)
+	valueWidth := textWidth(value)
+	width := labelWidth + valueWidth
+
+	label = html.EscapeString(label)
+	value = html.EscapeString(value)
+
+	var svg strings.Builder
+	svg.WriteString(fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="20" role="img" aria-label="%s: %s">`, width, label, value))
+	svg.WriteString(fmt.Sprintf(`<title>%s: %s</title>`, label, value))
+	svg.WriteString(`<linearGradient id="s" x2="0" y2="100%"><stop offset="0" stop-color="#bbb" stop-opacity=".1"/><stop offset="1" stop-opacity=".1"/></linearGradient>`)
+	svg.WriteString(fmt.Sprintf(`<clipPath id="r"><rect width="%d" height="20" rx="3" fill="#fff"/></clipPath>`, width))
+	svg.WriteString(`<g clip-path="url(#r)">`)
+	svg.WriteString(fmt.Sprintf(`<rect width="%d" height="20" fill="#555"/>`, labelWidth))
+	svg.WriteString(fmt.Sprintf(`<rect x="%d" width="%d" height="20" fill="%s"/>`, labelWidth, valueWidth, color))
+	svg.WriteString(fmt.Sprintf(`<rect width="%d" height="20" fill="url(#s)"/>`, width))
+	svg.WriteString(`</g>`)
+	svg.WriteString(`<g fill="#fff" text-anchor="middle" font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="11">`)
+	svg.WriteString(fmt.Sprintf(`<text x="%d" y="14">%s</text>`, labelWidth/2, label))
+	svg.WriteString(fmt.Sprintf(`<text x="%d" y="14">%s</text>`, labelWidth+valueWidth/2, value))
+	svg.WriteString(`</g></svg>`)
+	svg.WriteString("\n")
+
+	return svg.String()
+}
+
+// 📊 Badge-полоса: одна клетка на тему, зазор между уровнями
+func (tr *tracker) renderTopicsBadge(topics []analyzer.Topic) string {
+	const (
+		cell   = 8
+		gap    = 1
+		levelG = 4
+	)
+
+	label := tr.T("badge.topics")
+	labelWidth := textWidth(label)
+
+	completed := 0
+	x := labelWidth + levelG
+	var cells strings.Builder
+	for i, topic := range topics {
+		if i > 0 && topic.Level != topics[i-1].Level {
+			x += levelG
+		}
+		color := "#3a3f44"
+		if topic.Completed() {
+			color = "#4c1"
+			completed++
+		}
+		cells.WriteString(fmt.Sprintf(`<rect x="%d" y="5" width="%d" height="10" rx="1" fill="%s"><title>L%d %s</title></rect>`,
+			x, cell, color, topic.Level, html.EscapeString(tr.topicName(topic))))
+		x += cell + gap
+	}
+	width := x + levelG
+
+	var svg strings.Builder
+	svg.WriteString(fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="20" role="img" aria-label="Topics: %d/%d">`, width, completed, len(topics)))
+	svg.WriteString(fmt.Sprintf(`<title>Topics: %d/%d</title>`, completed, len(topics)))
+	svg.WriteString(fmt.Sprintf(`<clipPath id="r"><rect width="%d" height="20" rx="3" fill="#fff"/></clipPath>`, width))
+	svg.WriteString(`<g clip-path="url(#r)">`)
+	svg.WriteString(fmt.Sprintf(`<rect width="%d" height="20" fill="#555"/>`, labelWidth))
+	svg.WriteString(fmt.Sprintf(`<rect x="%d" width="%d" height="20" fill="#24292e"/>`, labelWidth, width-labelWidth))
+	svg.WriteString(`</g>`)
+	svg.WriteString(fmt.Sprintf(`<text x="%d" y="14" fill="#fff" text-anchor="middle" font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="11">%s</text>`, labelWidth/2, html.EscapeString(label)))
+	svg.WriteString(cells.String())
+	svg.WriteString(`</svg>`)
+	svg.WriteString("\n")
+
+	return svg.String()
+}
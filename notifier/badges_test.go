@@ -0,0 +1,121 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/yourusername/go-learning-tracker/tracker/analyzer"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 🏷️ Ширина badge по тексту, спецсимволы экранируются
+func TestRenderBadge(t *testing.T) {
+	tests := []struct {
+		name  string
+		label string
+		value string
+		width int
+		text  string
+	}{
+		{"латиница", "XP", "420", 24 + 31, `<text x="39" y="14">420</text>`},
+		{"кириллица", "до уровня", "max", 73 + 31, `<text x="88" y="14">max</text>`},
+		{"экранирование", "a<b", "x&y", 31 + 31, `<title>a&lt;b: x&amp;y</title>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svg := renderBadge(tt.label, tt.value, "#4c1")
+			if !strings.Contains(svg, fmt.Sprintf(`width="%d" height="20" role="img"`, tt.width)) {
+				t.Errorf("width %d not found:\n%s", tt.width, svg)
+			}
+			if !strings.Contains(svg, tt.text) {
+				t.Errorf("%q not found:\n%s", tt.text, svg)
+			}
+		})
+	}
+}
+
+// 📊 Полоса тем: клетка на тему, зелёные — изученные, зазор между уровнями
+func TestRenderTopicsBadge(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", nil)
+	topics := []analyzer.Topic{
+		{ID: "types", Level: 1, MinExamples: 1, Found: 1},
+		{ID: "variables", Level: 1, MinExamples: 1},
+		{ID: "structs", Level: 2, MinExamples: 1, Found: 3},
+	}
+
+	svg := tr.renderTopicsBadge(topics)
+	if !strings.Contains(svg, "<title>Topics: 2/3</title>") {
+		t.Errorf("counter not found:\n%s", svg)
+	}
+	if got := strings.Count(svg, `fill="#4c1"`); got != 2 {
+		t.Errorf("completed cells = %d, want 2", got)
+	}
+
+	// label 6 символов → 52px; +4 отступ, клетки по 9px, +4 между уровнями
+	for _, cell := range []string{
+		`<rect x="56" y="5" width="8" height="10" rx="1" fill="#4c1"><title>L1 ` + tr.topicName(topics[0]) + `</title>`,
+		`<rect x="65" y="5" width="8" height="10" rx="1" fill="#3a3f44"><title>L1 ` + tr.topicName(topics[1]) + `</title>`,
+		`<rect x="78" y="5" width="8" height="10" rx="1" fill="#4c1"><title>L2 ` + tr.topicName(topics[2]) + `</title>`,
+	} {
+		if !strings.Contains(svg, cell) {
+			t.Errorf("cell not found: %s\n%s", cell, svg)
+		}
+	}
+	if !strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg" width="91"`) {
+		t.Errorf("width: %s", svg[:80])
+	}
+
+	if empty := tr.renderTopicsBadge(nil); !strings.Contains(empty, "<title>Topics: 0/0</title>") {
+		t.Errorf("empty syllabus:\n%s", empty)
+	}
+}
+
+// 💾 FS, который не пишет выбранные файлы
+type failingFS struct {
+	store.MemFS
+	fail string
+}
+
+func (f failingFS) WriteFile(name string, data []byte, perm fs.FileMode) error {
+	if name == f.fail {
+		return errors.New("disk full")
+	}
+	return f.MemFS.WriteFile(name, data, perm)
+}
+
+// 🖼️ Все badges пишутся в каталог; сбой одного файла не мешает остальным
+func TestWriteLocalBadges(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", nil)
+	memfs := tr.fs.(store.MemFS)
+	stats := store.NewStats("alice")
+	stats.Level, stats.TotalXP, stats.League = 2, 420, "Gold"
+
+	lines, err := tr.writeLocalBadges(badgesDir, stats, 25)
+	if err != nil || len(lines) != 7 {
+		t.Fatalf("writeLocalBadges = %d lines, %v", len(lines), err)
+	}
+	if lines[0] != "![Level](badges/level.svg)" || lines[6] != "![Topics](badges/topics.svg)" {
+		t.Errorf("lines: %q", lines)
+	}
+	if xp := string(memfs["badges/xp.svg"].Data); !strings.Contains(xp, ">420</text>") {
+		t.Errorf("xp.svg:\n%s", xp)
+	}
+	if league := string(memfs["badges/league.svg"].Data); !strings.Contains(league, leagueColor("Gold")) {
+		t.Errorf("league.svg:\n%s", league)
+	}
+
+	tr.fs = failingFS{MemFS: store.MemFS{}, fail: "badges/xp.svg"}
+	lines, err = tr.writeLocalBadges(badgesDir, stats, 25)
+	if err == nil || len(lines) != 6 {
+		t.Errorf("failed write: %d lines, %v", len(lines), err)
+	}
+	for _, line := range lines {
+		if strings.Contains(line, "xp.svg") {
+			t.Errorf("link to unwritten badge: %s", line)
+		}
+	}
+}
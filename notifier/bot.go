@@ -0,0 +1,444 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yourusername/go-learning-tracker/tracker/achievements"
+	"github.com/yourusername/go-learning-tracker/tracker/leaderboard"
+	"github.com/yourusername/go-learning-tracker/tracker/notify"
+	"github.com/yourusername/go-learning-tracker/tracker/progress"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 🤖 Входящее обновление getUpdates (нужны только текстовые сообщения)
+type TGUpdate struct {
+	UpdateID int64       `json:"update_id"`
+	Message  *TGIncoming `json:"message"`
+}
+
+type TGIncoming struct {
+	Text string `json:"text"`
+	Chat struct {
+		ID int64 `json:"id"`
+	} `json:"chat"`
+	From struct {
+		Username string `json:"username"`
+	} `json:"from"`
+}
+
+type tgUpdatesResponse struct {
+	OK          bool       `json:"ok"`
+	Description string     `json:"description"`
+	Result      []TGUpdate `json:"result"`
+}
+
+// 🤖 Команда бота: обработчик получает аргументы после команды
+// Mentor — только для чатов менторов (REVIEW_MENTOR_CHATS)
+type botCommand struct {
+	Name   string
+	Handle func(tr *tracker, req botRequest) string
+	Mentor bool
+}
+
+// 📨 Команда из чата
+type botRequest struct {
+	Args   []string
+	ChatID string
+	From   string // username отправителя
+}
+
+// 📋 Команды в порядке показа в /help
+var botCommands = []botCommand{
+	{"stats", (*tracker).botStats, false},
+	{"next", (*tracker).botNext, false},
+	{"hint", (*tracker).botHint, false},
+	{"achievements", (*tracker).botAchievements, false},
+	{"leaderboard", (*tracker).botLeaderboard, false},
+	{"freeze", (*tracker).botFreeze, false},
+	{"heatmap", (*tracker).botHeatmap, false},
+	{"pending", (*tracker).botPending, true},
+	{"approve", (*tracker).botApprove, true},
+	{"reject", (*tracker).botReject, true},
+}
+
+// 💻 Команда bot: long polling getUpdates и ответы на команды
+func (tr *tracker) runBot(args []string) {
+	flags := flag.NewFlagSet("bot", flag.ExitOnError)
+	timeout := flags.Int("timeout", 30, tr.T("bot.timeout_flag"))
+	once := flags.Bool("once", false, tr.T("bot.once_flag"))
+	flags.Parse(args)
+
+	token := os.Getenv("TELEGRAM_TOKEN")
+	if token == "" {
+		fmt.Println(tr.T("telegram.no_tokens"))
+		os.Exit(exitError)
+	}
+
+	allowed := allowedChats()
+	for id := range mentorChats() {
+		allowed[id] = true
+	}
+	if len(allowed) == 0 {
+		fmt.Println(tr.T("bot.no_allowed_chats"))
+		os.Exit(exitError)
+	}
+
+	fmt.Println(tr.T("bot.started", len(allowed)))
+
+	var offset int64
+	for {
+		updates, err := tr.getUpdates(token, offset, *timeout)
+		if err != nil {
+			fmt.Println(tr.T("bot.poll_error", err))
+			if *once {
+				os.Exit(exitError)
+			}
+			time.Sleep(5 * time.Second)
+			continue
+		}
+
+		for _, update := range updates {
+			offset = update.UpdateID + 1
+			tr.handleUpdate(token, update, allowed)
+		}
+
+		if *once {
+			// Подтверждаем обработанные обновления, чтобы они не пришли снова
+			if offset > 0 {
+				tr.getUpdates(token, offset, 0)
+			}
+			return
+		}
+	}
+}
+
+// 🔐 Чаты, которым бот отвечает: TELEGRAM_ALLOWED_CHATS (через запятую)
+// или TELEGRAM_CHAT_ID
+func allowedChats() map[string]bool {
+	list := os.Getenv("TELEGRAM_ALLOWED_CHATS")
+	if list == "" {
+		list = os.Getenv("TELEGRAM_CHAT_ID")
+	}
+	return chatSet(list)
+}
+
+// 🧑‍🏫 Чаты менторов: REVIEW_MENTOR_CHATS (через запятую)
+func mentorChats() map[string]bool {
+	return chatSet(os.Getenv("REVIEW_MENTOR_CHATS"))
+}
+
+func chatSet(list string) map[string]bool {
+	allowed := map[string]bool{}
+	for _, id := range strings.Split(list, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			allowed[id] = true
+		}
+	}
+	return allowed
+}
+
+// 📥 getUpdates с long polling
+func (tr *tracker) getUpdates(token string, offset int64, timeout int) ([]TGUpdate, error) {
+	body, _ := json.Marshal(map[string]interface{}{
+		"offset":          offset,
+		"timeout":         timeout,
+		"allowed_updates": []string{"message"},
+	})
+
+	telegram := tr.telegramClient(token)
+	client := &http.Client{Timeout: time.Duration(timeout+10) * time.Second}
+	resp, err := client.Post(telegram.MethodURL("getUpdates"), "application/json", bytes.NewBuffer(body))
+	if err != nil {
+		return nil, telegram.Redact(err)
+	}
+	defer resp.Body.Close()
+
+	var result tgUpdatesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	if !result.OK {
+		return nil, fmt.Errorf("%d: %s", resp.StatusCode, result.Description)
+	}
+	return result.Result, nil
+}
+
+// 📨 Обработка одного сообщения
+func (tr *tracker) handleUpdate(token string, update TGUpdate, allowed map[string]bool) {
+	if update.Message == nil || update.Message.Text == "" {
+		return
+	}
+
+	chatID := strconv.FormatInt(update.Message.Chat.ID, 10)
+	if !allowed[chatID] {
+		fmt.Println(tr.T("bot.denied", chatID, update.Message.From.Username))
+		return
+	}
+
+	name, args, ok := parseBotCommand(update.Message.Text)
+	if !ok {
+		return
+	}
+	fmt.Println(tr.T("bot.command", chatID, name))
+
+	req := botRequest{Args: args, ChatID: chatID, From: update.Message.From.Username}
+	reply := tr.T("bot.unknown", name)
+	switch name {
+	case "start", "help":
+		reply = tr.botHelp(chatID)
+	default:
+		for _, command := range botCommands {
+			if command.Name != name {
+				continue
+			}
+			if command.Mentor && !mentorChats()[chatID] {
+				reply = tr.T("bot.mentor_only")
+			} else {
+				reply = command.Handle(tr, req)
+			}
+			break
+		}
+	}
+
+	if _, err := tr.telegramClient(token).Deliver(chatID, reply); err != nil {
+		fmt.Println(tr.T("telegram.send_error", tr.telegramError(err)))
+	}
+}
+
+// 🔤 "/cmd@bot arg1 arg2" → "cmd", [arg1 arg2]
+func parseBotCommand(text string) (string, []string, bool) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 || !strings.HasPrefix(fields[0], "/") {
+		return "", nil, false
+	}
+
+	name := strings.TrimPrefix(fields[0], "/")
+	if at := strings.Index(name, "@"); at >= 0 {
+		name = name[:at]
+	}
+	return strings.ToLower(name), fields[1:], true
+}
+
+// 🧮 Свежий анализ кода (stats.json не меняется)
+func (tr *tracker) botAnalyze(stats store.UserStats) (completed int) {
+	tr.analyzeCodebase(false)
+	if tr.kataModeEnabled() {
+		tr.applyKataResults(tr.savedKataResults(stats.PassedKatas))
+	}
+	tr.holdTopics(stats)
+
+	for _, topic := range tr.syllabus {
+		if topic.Completed() {
+			completed++
+		}
+	}
+	return completed
+}
+
+// 📊 /stats — компактный отчёт
+func (tr *tracker) botStats(req botRequest) string {
+	stats := tr.loadStats()
+	completed := tr.botAnalyze(stats)
+
+	data := tr.newReportData(stats, completed, len(tr.syllabus))
+	data.NextTopic = tr.T("run.all_done")
+	if topic := tr.nextIncompleteTopic(); topic != nil {
+		data.NextTopic = tr.topicName(*topic)
+	}
+	return tr.renderReport("bot", data)
+}
+
+// 🎯 /next — следующая тема и что осталось на уровне
+func (tr *tracker) botNext(req botRequest) string {
+	tr.botAnalyze(tr.loadStats())
+
+	topic := tr.nextIncompleteTopic()
+	if topic == nil {
+		return tr.T("run.all_done")
+	}
+
+	var text strings.Builder
+	text.WriteString(notify.Bold(tr.T("report.next_goal", tr.topicName(*topic))) + "\n")
+	for _, other := range tr.syllabus {
+		if other.Level != topic.Level {
+			continue
+		}
+		mark := "→"
+		if other.Completed() {
+			mark = "✓"
+		}
+		text.WriteString(fmt.Sprintf("  %s %s (%d/%d)\n", mark, tr.topicName(other), other.Found, other.MinExamples))
+	}
+	return text.String()
+}
+
+// 💡 /hint — подсказка с упражнением
+func (tr *tracker) botHint(req botRequest) string {
+	stats := tr.loadStats()
+	tr.botAnalyze(stats)
+
+	topic := tr.nextIncompleteTopic()
+	if topic == nil {
+		return tr.T("run.all_done")
+	}
+
+	hint := tr.buildHint(*topic, tr.loadHintBank(hintsDir), int(tr.clock.Now().Unix()))
+	if tr.kataModeEnabled() {
+		hint.Katas = pendingKatas(topic.ID, tr.savedKataResults(stats.PassedKatas))
+	}
+	if tr.needsReviews(stats, nil) {
+		hint.Review = topicReview(*topic, latestReviews(tr.loadReviews(stats.Username)))
+	}
+	return notify.Bold(tr.T("report.next_goal", tr.topicName(*topic))) + "\n" + tr.formatHint(hint)
+}
+
+// 🏆 /achievements — открытые и закрытые достижения
+func (tr *tracker) botAchievements(req botRequest) string {
+	stats := tr.loadStats()
+	unlocked := map[string]bool{}
+	for _, ach := range stats.Achievements {
+		unlocked[ach.ID] = true
+	}
+
+	var text strings.Builder
+	text.WriteString(notify.Bold(tr.T("bot.achievements_title", len(unlocked), len(achievements.All()))) + "\n")
+	for _, ach := range tr.localizedAchievements() {
+		status := "🔒"
+		if unlocked[ach.ID] {
+			status = "✅"
+		}
+		text.WriteString(fmt.Sprintf("%s %s %s — %s (+%d XP)\n", status, ach.Icon, ach.Name, ach.Description, ach.XPReward))
+	}
+	return text.String()
+}
+
+// 🌍 /leaderboard — топ-10 и своё место
+func (tr *tracker) botLeaderboard(req botRequest) string {
+	webhookURL := os.Getenv("LEADERBOARD_WEBHOOK")
+	if webhookURL == "" {
+		return tr.T("leaderboard.not_configured")
+	}
+
+	rows, err := leaderboard.Fetch(webhookURL)
+	if err != nil {
+		return tr.T("render.fetch_error", err)
+	}
+
+	stats := tr.loadStats()
+
+	var text strings.Builder
+	text.WriteString(notify.Bold(tr.T("bot.leaderboard_title", len(rows))) + "\n")
+	for i, row := range rows {
+		line := fmt.Sprintf("%s %s — %d XP · Level %d", positionMedal(i+1), row.Username, row.XP, row.Level)
+		if row.Username == stats.Username {
+			line = notify.Bold(line)
+		}
+		if i < 10 || row.Username == stats.Username {
+			text.WriteString(line + "\n")
+		}
+	}
+	return text.String()
+}
+
+// 🧊 /freeze [YYYY-MM-DD] — заморозить день (по умолчанию завтра)
+func (tr *tracker) botFreeze(req botRequest) string {
+	now := tr.clock.Now()
+	day := now.AddDate(0, 0, 1)
+	if len(req.Args) > 0 {
+		parsed, err := time.Parse("2006-01-02", req.Args[0])
+		if err != nil {
+			return tr.T("bot.freeze_usage")
+		}
+		day = parsed
+	}
+
+	stats := tr.loadStats()
+	date := day.Format("2006-01-02")
+	limit := tr.config.FreezesPerMonth
+	switch err := progress.FreezeDay(&stats, day, now, limit); err {
+	case nil:
+	case progress.ErrFreezePast:
+		return tr.T("freeze.past", date)
+	case progress.ErrFreezeAlready:
+		return tr.T("freeze.already", date)
+	case progress.ErrFreezeLimit:
+		return tr.T("freeze.limit", limit, date[:7])
+	default:
+		return err.Error()
+	}
+	tr.saveStats(stats)
+
+	return tr.T("bot.frozen", date, progress.FreezesLeft(stats, day, limit))
+}
+
+// 🟩 /heatmap [недель] — карта активности (по умолчанию 12 недель)
+func (tr *tracker) botHeatmap(req botRequest) string {
+	weeks := 12
+	if len(req.Args) > 0 {
+		parsed, err := strconv.Atoi(req.Args[0])
+		if err != nil || parsed < 1 || parsed > 53 {
+			return tr.T("bot.heatmap_usage")
+		}
+		weeks = parsed
+	}
+
+	now := tr.clock.Now()
+	activity := tr.collectActivity(tr.loadStats(), heatmapStart(now, weeks))
+	return notify.Bold(tr.T("heatmap.title")) + "\n" + tr.renderHeatmapEmoji(activity, tr.heatmapMetric(), now, weeks)
+}
+
+// 🧑‍🏫 /pending — темы, ждущие одобрения
+func (tr *tracker) botPending(req botRequest) string {
+	stats := tr.loadStats()
+	tr.botAnalyze(stats)
+	return tr.formatPendingReviews()
+}
+
+// ✅ /approve <тема> [комментарий]
+func (tr *tracker) botApprove(req botRequest) string {
+	return tr.botReview(req, reviewApproved)
+}
+
+// ↩️ /reject <тема> <комментарий>
+func (tr *tracker) botReject(req botRequest) string {
+	return tr.botReview(req, reviewRejected)
+}
+
+func (tr *tracker) botReview(req botRequest, status string) string {
+	if len(req.Args) == 0 {
+		return tr.T("review.usage")
+	}
+
+	mentor := req.From
+	if mentor == "" {
+		mentor = req.ChatID
+	}
+
+	review, err := tr.addReview(tr.loadStats().Username, req.Args[0], status, mentor, strings.Join(req.Args[1:], " "))
+	if err != nil {
+		return err.Error()
+	}
+	return tr.T("review.saved_"+review.Status, tr.topicDisplayName(review.Topic), review.Learner)
+}
+
+// ❓ /help и /start (команды менторов — только в их чатах)
+func (tr *tracker) botHelp(chatID string) string {
+	var text strings.Builder
+	text.WriteString(notify.Bold("🎮 Go Learning Tracker") + "\n")
+	for _, command := range botCommands {
+		if command.Mentor && !mentorChats()[chatID] {
+			continue
+		}
+		text.WriteString(fmt.Sprintf("/%s — %s\n", command.Name, tr.T("bot.help."+command.Name)))
+	}
+	text.WriteString("/help — " + tr.T("bot.help.help") + "\n")
+	return text.String()
+}
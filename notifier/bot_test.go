@@ -0,0 +1,142 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/yourusername/go-learning-tracker/tracker/notify"
+)
+
+// 🤖 Фейковый Bot API: getUpdates отдаёт пачки по очереди, sendMessage
+// запоминает ответы бота
+type fakeBotAPI struct {
+	batches [][]TGUpdate
+	offsets []int64 // offset каждого getUpdates
+	sent    []notify.TGMessage
+}
+
+func useFakeBotAPI(t *testing.T, batches ...[]TGUpdate) *fakeBotAPI {
+	t.Helper()
+	api := &fakeBotAPI{batches: batches}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/botsecret/getUpdates", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Offset int64 `json:"offset"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		api.offsets = append(api.offsets, req.Offset)
+
+		var updates []TGUpdate
+		if len(api.batches) > 0 {
+			updates, api.batches = api.batches[0], api.batches[1:]
+		}
+		json.NewEncoder(w).Encode(tgUpdatesResponse{OK: true, Result: updates})
+	})
+	mux.HandleFunc("/botsecret/sendMessage", func(w http.ResponseWriter, r *http.Request) {
+		var msg notify.TGMessage
+		json.NewDecoder(r.Body).Decode(&msg)
+		api.sent = append(api.sent, msg)
+		w.Write([]byte(`{"ok":true}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	t.Setenv("TELEGRAM_API_URL", server.URL)
+	t.Setenv("TELEGRAM_TOKEN", "secret")
+	t.Setenv("TELEGRAM_CHAT_ID", "")
+	t.Setenv("TELEGRAM_ALLOWED_CHATS", "100")
+	t.Setenv("REVIEW_MENTOR_CHATS", "200")
+	return api
+}
+
+func botMessage(id, chat int64, text string) TGUpdate {
+	update := TGUpdate{UpdateID: id, Message: &TGIncoming{Text: text}}
+	update.Message.Chat.ID = chat
+	update.Message.From.Username = "alice"
+	return update
+}
+
+// 📨 Ответы по чатам в порядке отправки
+func (api *fakeBotAPI) replies(chat string) []string {
+	var texts []string
+	for _, msg := range api.sent {
+		if msg.ChatID == chat {
+			texts = append(texts, msg.Text)
+		}
+	}
+	return texts
+}
+
+func markdownV2(text string) string {
+	return notify.Apply(text, notify.MarkdownV2)
+}
+
+func TestBotCommands(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", map[string]string{"basics/main.go": basicsSource})
+	api := useFakeBotAPI(t, []TGUpdate{
+		botMessage(10, 100, "/help"),
+		botMessage(11, 100, "/freeze@tracker_bot 2026-03-05"),
+		botMessage(12, 100, "/nope"),
+		botMessage(13, 100, "просто текст"),
+		botMessage(14, 999, "/stats"),
+		botMessage(15, 100, "/approve types"),
+		botMessage(16, 200, "/help"),
+		botMessage(17, 200, "/pending"),
+	})
+
+	tr.runBot([]string{"-once", "-timeout", "0"})
+
+	user := api.replies("100")
+	want := []string{
+		markdownV2(tr.botHelp("100")),
+		markdownV2(tr.T("bot.frozen", "2026-03-05", 1)),
+		markdownV2(tr.T("bot.unknown", "nope")),
+		markdownV2(tr.T("bot.mentor_only")),
+	}
+	if len(user) != len(want) {
+		t.Fatalf("chat 100 got %d replies:\n%s", len(user), strings.Join(user, "\n---\n"))
+	}
+	for i := range want {
+		if user[i] != want[i] {
+			t.Errorf("reply %d = %q, want %q", i, user[i], want[i])
+		}
+	}
+	if strings.Contains(user[0], "/approve") {
+		t.Errorf("mentor commands shown to a learner:\n%s", user[0])
+	}
+
+	// Чужой чат не получает ответа, ментор видит свои команды
+	if denied := api.replies("999"); len(denied) != 0 {
+		t.Errorf("chat 999 got replies: %q", denied)
+	}
+	mentor := api.replies("200")
+	if len(mentor) != 2 || !strings.Contains(mentor[0], "/approve") || mentor[1] != markdownV2(tr.T("review.none_pending")+"\n") {
+		t.Errorf("mentor replies: %q", mentor)
+	}
+
+	if frozen := tr.loadStats().FrozenDays; len(frozen) != 1 || frozen[0] != "2026-03-05" {
+		t.Errorf("FrozenDays = %v", frozen)
+	}
+}
+
+// ✅ После пачки обновлений offset подтверждает последнее, чтобы оно не
+// пришло снова; пустая пачка подтверждения не требует
+func TestBotAcknowledgesOffset(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", nil)
+	api := useFakeBotAPI(t, []TGUpdate{botMessage(41, 100, "/nope"), botMessage(42, 999, "/nope")})
+
+	tr.runBot([]string{"-once", "-timeout", "0"})
+	if len(api.offsets) != 2 || api.offsets[0] != 0 || api.offsets[1] != 43 {
+		t.Errorf("getUpdates offsets = %v, want [0 43]", api.offsets)
+	}
+
+	api.offsets = nil
+	tr.runBot([]string{"-once", "-timeout", "0"})
+	if len(api.offsets) != 1 {
+		t.Errorf("empty batch: getUpdates offsets = %v, want one poll", api.offsets)
+	}
+}
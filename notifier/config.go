@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/yourusername/go-learning-tracker/tracker/config"
+)
+
+// 📄 Путь к файлу настроек
+func configPath() string {
+	if path := os.Getenv("TRACKER_CONFIG"); path != "" {
+		return path
+	}
+	return config.File
+}
+
+// 📥 Настройки из файла и окружения
+// Путь вне репозитория (абсолютный или через ..) читается с диска напрямую
+func (tr *tracker) loadConfig() (config.Config, []config.Field, error) {
+	path := filepath.ToSlash(filepath.Clean(configPath()))
+	if fs.ValidPath(path) {
+		return config.Load(tr.fs, path, os.Getenv)
+	}
+	dir, name := filepath.Split(filepath.FromSlash(path))
+	return config.Load(os.DirFS(dir), name, os.Getenv)
+}
+
+// 💻 Команда config print: итоговые настройки и откуда взято каждое значение
+func (tr *tracker) runConfig(args []string, fields []config.Field) {
+	if len(args) == 0 || args[0] != "print" {
+		fmt.Println(tr.T("config.usage"))
+		os.Exit(exitUsage)
+	}
+
+	fmt.Println(tr.T("config.title", configPath()))
+	table := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, tr.T("config.header"))
+	for _, field := range fields {
+		source := field.Source
+		if source == config.SourceEnv {
+			source = "env " + field.Env
+		}
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\n", field.Key, field.Value, source, field.Env)
+	}
+	table.Flush()
+}
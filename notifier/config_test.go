@@ -0,0 +1,30 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/yourusername/go-learning-tracker/tracker/progress"
+)
+
+// 📄 TRACKER_CONFIG вне репозитория читается с диска
+func TestLoadConfigOutsideRepo(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", nil)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "tracker.json")
+	if err := os.WriteFile(path, []byte(`{"freezes_per_month": 5}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("TRACKER_CONFIG", path)
+	cfg, _, err := tr.loadConfig()
+	if err != nil || cfg.FreezesPerMonth != 5 {
+		t.Errorf("absolute path: %d, %v", cfg.FreezesPerMonth, err)
+	}
+
+	t.Setenv("TRACKER_CONFIG", filepath.Join(dir, "missing.json"))
+	if cfg, _, err := tr.loadConfig(); err != nil || cfg.FreezesPerMonth != progress.FreezesPerMonth {
+		t.Errorf("missing file: %d, %v", cfg.FreezesPerMonth, err)
+	}
+}
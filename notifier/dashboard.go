@@ -0,0 +1,255 @@
+package main
+
+import (
+	"embed"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yourusername/go-learning-tracker/tracker/achievements"
+	"github.com/yourusername/go-learning-tracker/tracker/leaderboard"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 🖥️ Шаблоны и стили дашборда встроены в бинарник — работает офлайн
+//
+//go:embed web
+var webAssets embed.FS
+
+// t — перевод на язык трекера, подставляется при выводе страницы
+var dashboardTemplate = template.Must(template.New("dashboard").Funcs(template.FuncMap{
+	"inc": func(i int) int { return i + 1 },
+	"t":   fmt.Sprintf,
+}).ParseFS(webAssets, "web/templates/*.html"))
+
+// 🕒 Leaderboard запрашивается не чаще раза в минуту: медленный webhook
+// не задерживает каждую страницу
+const dashboardLeaderboardTTL = time.Minute
+
+// 🗃️ Последний ответ webhook (и ошибка — повтор только после TTL)
+type leaderboardCache struct {
+	mu      sync.Mutex
+	url     string
+	fetched time.Time
+	rows    []leaderboard.Row
+	err     error
+}
+
+func (c *leaderboardCache) get(webhookURL string, now time.Time) ([]leaderboard.Row, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.url != webhookURL || c.fetched.IsZero() || now.Sub(c.fetched) >= dashboardLeaderboardTTL {
+		c.rows, c.err = leaderboard.Fetch(webhookURL)
+		c.url, c.fetched = webhookURL, now
+	}
+	return c.rows, c.err
+}
+
+// 📊 Данные для страницы дашборда
+type dashboardData struct {
+	Stats            store.UserStats
+	LevelName        string
+	Percent          float64
+	Completed        int
+	Total            int
+	Levels           []levelView
+	Achievements     []achievementView
+	Chart            chartView
+	Leaderboard      []leaderboard.Row
+	LeaderboardError string
+	GeneratedAt      string
+	Lang             string
+}
+
+// 📚 Темы одного уровня
+type levelView struct {
+	Level  int
+	Name   string
+	Topics []topicView
+}
+
+type topicView struct {
+	Name      string
+	Found     int
+	Need      int
+	Percent   int
+	Completed bool
+}
+
+// 🏆 Достижение в галерее
+type achievementView struct {
+	achievements.Achievement
+	Unlocked bool
+}
+
+// 📈 График XP (SVG собирается на сервере)
+type chartView struct {
+	Width  int
+	Height int
+	Points string
+	Dots   []chartDot
+	MaxXP  int
+	From   string
+	To     string
+	Empty  bool
+}
+
+type chartDot struct {
+	X, Y    int
+	Date    string
+	TotalXP int
+}
+
+// 💻 Команда serve: локальный дашборд
+func (tr *tracker) runServe(args []string) {
+	flags := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := flags.String("addr", "127.0.0.1:8080", tr.T("serve.addr_flag"))
+	flags.Parse(args)
+
+	static, _ := fs.Sub(webAssets, "web/static")
+
+	// Кэш leaderboard общий для всех запросов к этому серверу
+	cache := &leaderboardCache{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) { tr.handleDashboard(w, r, cache) })
+	mux.HandleFunc("/api/stats", tr.handleStatsAPI)
+	mux.Handle("/static/", http.StripPrefix("/static/", http.FileServer(http.FS(static))))
+
+	fmt.Println(tr.T("serve.listening", *addr))
+	if err := http.ListenAndServe(*addr, mux); err != nil {
+		fmt.Println(tr.T("serve.error", err))
+		os.Exit(exitError)
+	}
+}
+
+// 🏠 Главная страница
+func (tr *tracker) handleDashboard(w http.ResponseWriter, r *http.Request, cache *leaderboardCache) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+
+	// Свой анализ syllabus на каждый запрос — запросы не мешают друг другу
+	data := tr.learner(tr.dir).buildDashboardData(cache)
+
+	page, err := dashboardTemplate.Clone()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	page.Funcs(template.FuncMap{"t": tr.T})
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := page.ExecuteTemplate(w, "index.html", data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// 🔌 stats.json как есть (для своих скриптов)
+func (tr *tracker) handleStatsAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(tr.loadStats())
+}
+
+// 🧮 Сбор данных для дашборда (статистика не изменяется)
+func (tr *tracker) buildDashboardData(cache *leaderboardCache) dashboardData {
+	stats := tr.loadStats()
+	tr.analyzeCodebase(false)
+	tr.holdTopics(stats)
+
+	data := dashboardData{
+		Stats:       stats,
+		LevelName:   tr.getLevelName(stats.Level),
+		Total:       len(tr.syllabus),
+		Chart:       buildChart(stats.History, 640, 200),
+		GeneratedAt: tr.clock.Now().Format("2006-01-02 15:04"),
+		Lang:        tr.lang,
+	}
+
+	for level := 1; level <= tr.maxLevel(); level++ {
+		view := levelView{Level: level, Name: tr.getLevelName(level)}
+		for _, topic := range tr.syllabus {
+			if topic.Level != level {
+				continue
+			}
+			percent := 100
+			if topic.Found < topic.MinExamples {
+				percent = topic.Found * 100 / topic.MinExamples
+			}
+			view.Topics = append(view.Topics, topicView{
+				Name:      tr.topicName(topic),
+				Found:     topic.Found,
+				Need:      topic.MinExamples,
+				Percent:   percent,
+				Completed: topic.Completed(),
+			})
+			if topic.Completed() {
+				data.Completed++
+			}
+		}
+		data.Levels = append(data.Levels, view)
+	}
+	data.Percent = float64(data.Completed) / float64(data.Total) * 100
+
+	unlocked := map[string]bool{}
+	for _, ach := range stats.Achievements {
+		unlocked[ach.ID] = true
+	}
+	for _, ach := range tr.localizedAchievements() {
+		data.Achievements = append(data.Achievements, achievementView{Achievement: ach, Unlocked: unlocked[ach.ID]})
+	}
+
+	if webhookURL := os.Getenv("LEADERBOARD_WEBHOOK"); webhookURL != "" {
+		rows, err := cache.get(webhookURL, tr.clock.Now())
+		if err != nil {
+			data.LeaderboardError = err.Error()
+		}
+		data.Leaderboard = rows
+	}
+
+	return data
+}
+
+// 📈 Координаты графика XP по истории
+func buildChart(history []store.HistoryPoint, width, height int) chartView {
+	chart := chartView{Width: width, Height: height, Empty: len(history) == 0}
+	if chart.Empty {
+		return chart
+	}
+
+	const padding = 20
+	for _, point := range history {
+		if point.TotalXP > chart.MaxXP {
+			chart.MaxXP = point.TotalXP
+		}
+	}
+	maxXP := chart.MaxXP
+	if maxXP == 0 {
+		maxXP = 1
+	}
+
+	var points []string
+	for i, point := range history {
+		x := padding
+		if len(history) > 1 {
+			x = padding + i*(width-2*padding)/(len(history)-1)
+		}
+		y := height - padding - point.TotalXP*(height-2*padding)/maxXP
+
+		points = append(points, fmt.Sprintf("%d,%d", x, y))
+		chart.Dots = append(chart.Dots, chartDot{X: x, Y: y, Date: point.Date, TotalXP: point.TotalXP})
+	}
+
+	chart.Points = strings.Join(points, " ")
+	chart.From = history[0].Date
+	chart.To = history[len(history)-1].Date
+	return chart
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// 🕒 Leaderboard дашборда: один запрос к webhook на TTL, ошибка тоже кэшируется
+func TestDashboardLeaderboardCache(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 2 {
+			http.Error(w, "down", http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{"status":"ok","leaderboard":[{"username":"alice","xp":120}]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	var cache leaderboardCache
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	steps := []struct {
+		after    time.Duration
+		requests int
+		ok       bool
+	}{
+		{0, 1, true},
+		{30 * time.Second, 1, true},
+		{dashboardLeaderboardTTL, 2, false},
+		{dashboardLeaderboardTTL + 30*time.Second, 2, false},
+		{2 * dashboardLeaderboardTTL, 3, true},
+	}
+	for _, step := range steps {
+		rows, err := cache.get(server.URL, start.Add(step.after))
+		if requests != step.requests || (err == nil) != step.ok {
+			t.Errorf("+%v: %d requests, err %v", step.after, requests, err)
+		}
+		if step.ok && (len(rows) != 1 || rows[0].Username != "alice") {
+			t.Errorf("+%v: rows %+v", step.after, rows)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/yourusername/go-learning-tracker/tracker/achievements"
+	"github.com/yourusername/go-learning-tracker/tracker/notify"
+	"github.com/yourusername/go-learning-tracker/tracker/progress"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 🗓️ Периоды дайджеста
+var digestPeriods = map[string]int{
+	"week":  7,
+	"month": 30,
+}
+
+// 📰 Данные шаблона дайджеста (templates/report/digest.tmpl)
+type DigestData struct {
+	Period         string // week или month
+	Days           int
+	From           string
+	To             string
+	Stats          store.UserStats
+	LevelName      string
+	LevelFrom      int                  // Уровень в начале периода
+	XP             progress.XPBreakdown // XP по источникам за период
+	PrevXP         progress.XPBreakdown // То же за предыдущий период
+	XPChange       int                  // Изменение TotalXP за период
+	PrevXPChange   int
+	Topics         []string // Темы, изученные за период
+	Achievements   []achievements.Achievement
+	ActiveDays     int
+	PrevActiveDays int
+	Heatmap        string // Эмодзи-карта активности за период (heatmap.go)
+	Position       int    // Место сейчас (0 — нет данных)
+	PrevPosition   int    // Место в начале периода
+}
+
+// 📈 Движение в leaderboard: >0 — поднялся на столько мест
+func (d DigestData) Movement() int {
+	if d.Position == 0 || d.PrevPosition == 0 {
+		return 0
+	}
+	return d.PrevPosition - d.Position
+}
+
+// 📊 Разница XP с предыдущим периодом
+func (d DigestData) XPDelta() int {
+	return d.XPChange - d.PrevXPChange
+}
+
+// 💻 Команда digest: сводка за неделю или месяц
+// Ничего не сохраняет: streak, коммиты и XP не меняются
+func (tr *tracker) runDigest(args []string) {
+	period := "week"
+	if len(args) > 0 {
+		period = args[0]
+	}
+
+	days, ok := digestPeriods[period]
+	if !ok {
+		fmt.Println(tr.T("digest.unknown_period", period))
+		os.Exit(exitUsage)
+	}
+
+	data := tr.buildDigest(tr.loadStats(), period, days, tr.clock.Now())
+
+	if webhookURL := os.Getenv("LEADERBOARD_WEBHOOK"); webhookURL != "" {
+		data.Position = leaderboardPosition(data.Stats.Username, webhookURL).Position
+	}
+
+	message := tr.renderReport("digest", data)
+	fmt.Println(notify.Apply(message, notify.Console))
+	tr.recordDelivery("telegram", tr.sendToTelegram(message))
+	tr.finishRun(nil)
+}
+
+// 🧮 Сводка по истории за days дней до now включительно
+func (tr *tracker) buildDigest(stats store.UserStats, period string, days int, now time.Time) DigestData {
+	to := now.Format("2006-01-02")
+	from := now.AddDate(0, 0, -days+1).Format("2006-01-02")
+	prevFrom := now.AddDate(0, 0, -2*days+1).Format("2006-01-02")
+
+	data := DigestData{
+		Period:    period,
+		Days:      days,
+		From:      from,
+		To:        to,
+		Stats:     stats,
+		LevelName: tr.getLevelName(stats.Level),
+		LevelFrom: stats.Level,
+	}
+
+	// Точка отсчёта XP — последняя точка до периода; если её нет (история
+	// началась в периоде), то XP перед первой точкой периода: её TotalXP
+	// минус заработанное в тот день, а не весь XP ученика
+	unlocked := map[string]bool{}
+	var startXP, prevStartXP, endXP, prevEndXP int
+	var hasStart, hasPrevStart bool
+
+	for _, point := range stats.History {
+		switch {
+		case point.Date < prevFrom:
+			prevStartXP, startXP = point.TotalXP, point.TotalXP
+			hasPrevStart, hasStart = true, true
+			data.PrevPosition = nonZero(point.Position, data.PrevPosition)
+		case point.Date < from:
+			if !hasPrevStart {
+				prevStartXP, hasPrevStart = xpBefore(point), true
+			}
+			addHistoryXP(&data.PrevXP, point)
+			data.PrevActiveDays++
+			prevEndXP, startXP = point.TotalXP, point.TotalXP
+			hasStart = true
+			data.PrevPosition = nonZero(point.Position, data.PrevPosition)
+		case point.Date <= to:
+			if !hasStart {
+				startXP, hasStart = xpBefore(point), true
+			}
+			if data.ActiveDays == 0 {
+				data.LevelFrom = point.Level
+			}
+			addHistoryXP(&data.XP, point)
+			data.ActiveDays++
+			endXP = point.TotalXP
+			for _, id := range point.NewTopics {
+				data.Topics = append(data.Topics, tr.topicDisplayName(id))
+			}
+			for _, id := range point.NewAchievements {
+				unlocked[id] = true
+			}
+		}
+	}
+
+	if data.ActiveDays > 0 {
+		data.XPChange = endXP - startXP
+	}
+	if data.PrevActiveDays > 0 {
+		data.PrevXPChange = prevEndXP - prevStartXP
+	}
+	data.XP.NewTopics = data.Topics
+
+	for _, ach := range tr.localizedAchievements() {
+		if unlocked[ach.ID] {
+			data.Achievements = append(data.Achievements, ach)
+		}
+	}
+
+	weeks := heatmapWeeksSince(now, now.AddDate(0, 0, -days+1))
+	activity := tr.collectActivity(stats, heatmapStart(now, weeks))
+	data.Heatmap = tr.renderHeatmapEmoji(activity, tr.heatmapMetric(), now, weeks)
+	return data
+}
+
+// ➕ XP одной точки истории в сводку
+func addHistoryXP(xp *progress.XPBreakdown, point store.HistoryPoint) {
+	xp.Topics += point.XPTopics
+	xp.Streak += point.XPStreak
+	xp.Achievements += point.XPAchievements
+	xp.Penalty += point.XPPenalty
+	xp.Season += point.XPSeason
+}
+
+// ⏮️ TotalXP до запусков дня point
+func xpBefore(point store.HistoryPoint) int {
+	var xp progress.XPBreakdown
+	addHistoryXP(&xp, point)
+	return point.TotalXP - xp.Net()
+}
+
+func nonZero(value, fallback int) int {
+	if value != 0 {
+		return value
+	}
+	return fallback
+}
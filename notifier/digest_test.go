@@ -0,0 +1,51 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/yourusername/go-learning-tracker/tracker/clock"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 💰 XP за период: от последней точки до периода, а если история
+// началась позже — от XP перед первой точкой, а не от нуля
+func TestDigestXPChange(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-10", nil)
+
+	tests := []struct {
+		name    string
+		history []store.HistoryPoint
+		change  int
+		prev    int
+	}{
+		{"первый дайджест", []store.HistoryPoint{
+			{Date: "2026-03-05", TotalXP: 220, XPTopics: 100, XPStreak: 20, XPAchievements: 100},
+		}, 220, 0},
+		// XP, заработанный до появления истории, не попадает в неделю
+		{"история началась в периоде", []store.HistoryPoint{
+			{Date: "2026-03-05", TotalXP: 2120, XPTopics: 100},
+			{Date: "2026-03-06", TotalXP: 2090, XPPenalty: 30},
+		}, 70, 0},
+		{"точка до периода", []store.HistoryPoint{
+			{Date: "2026-02-01", TotalXP: 500, XPTopics: 500},
+			{Date: "2026-03-05", TotalXP: 650, XPTopics: 150},
+		}, 150, 0},
+		{"история началась в прошлом периоде", []store.HistoryPoint{
+			{Date: "2026-02-26", TotalXP: 1050, XPTopics: 50},
+			{Date: "2026-03-05", TotalXP: 1150, XPTopics: 100},
+		}, 100, 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := store.NewStats("alice")
+			stats.History = tt.history
+			stats.TotalXP = tt.history[len(tt.history)-1].TotalXP
+
+			data := tr.buildDigest(stats, "week", 7, clock.At("2026-03-10").Now())
+			if data.XPChange != tt.change || data.PrevXPChange != tt.prev {
+				t.Errorf("XPChange = %d, PrevXPChange = %d, want %d, %d", data.XPChange, data.PrevXPChange, tt.change, tt.prev)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 🗃️ Чтение истории git без checkout: коммиты, деревья и blob-объекты
+// (simulate и pr)
+
+// 🧾 Коммит из истории
+type gitCommit struct {
+	Hash string
+	Date time.Time
+}
+
+// 📜 Коммиты ветки от первого к последнему (только первые родители —
+// как они попадали в main)
+func gitHistory(rev string) ([]gitCommit, error) {
+	output, err := exec.Command("git", "log", "--reverse", "--first-parent", "--format=%H %cI", rev, "--").Output()
+	if err != nil {
+		return nil, gitError(err)
+	}
+
+	var commits []gitCommit
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		hash, date, ok := strings.Cut(line, " ")
+		if !ok {
+			continue
+		}
+		when, err := time.Parse(time.RFC3339, date)
+		if err != nil {
+			return nil, err
+		}
+		commits = append(commits, gitCommit{Hash: hash, Date: when})
+	}
+	return commits, nil
+}
+
+// 📂 Учебный код коммита и reviews.json ученика: путь → blob
+func (tr *tracker) learningBlobs(commit string) (map[string]string, error) {
+	output, err := exec.Command("git", "ls-tree", "-r", "-z", "--full-tree", commit).Output()
+	if err != nil {
+		return nil, gitError(err)
+	}
+
+	files := map[string]string{}
+	for _, entry := range strings.Split(string(output), "\x00") {
+		// <mode> <type> <hash>\t<path>
+		meta, path, ok := strings.Cut(entry, "\t")
+		fields := strings.Fields(meta)
+		if !ok || len(fields) != 3 || fields[1] != "blob" {
+			continue
+		}
+		if tr.isLearningFile(path) || path == tr.reviewsPath() {
+			files[path] = fields[2]
+		}
+	}
+	return files, nil
+}
+
+// 🗄️ Чтение объектов через один процесс git cat-file --batch
+type gitObjects struct {
+	cmd   *exec.Cmd
+	in    io.WriteCloser
+	out   *bufio.Reader
+	cache map[string][]byte
+}
+
+func openGitObjects() (*gitObjects, error) {
+	cmd := exec.Command("git", "cat-file", "--batch")
+	in, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	out, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	return &gitObjects{cmd: cmd, in: in, out: bufio.NewReader(out), cache: map[string][]byte{}}, nil
+}
+
+// 📄 Содержимое blob (одинаковые файлы в разных коммитах читаются один раз)
+func (g *gitObjects) Blob(hash string) ([]byte, error) {
+	if data, ok := g.cache[hash]; ok {
+		return data, nil
+	}
+
+	fmt.Fprintln(g.in, hash)
+	// <hash> blob <size>
+	header, err := g.out.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	fields := strings.Fields(header)
+	if len(fields) != 3 || fields[1] != "blob" {
+		return nil, fmt.Errorf("git cat-file: %s", strings.TrimSpace(header))
+	}
+	size, err := strconv.Atoi(fields[2])
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, size+1) // Содержимое и перевод строки после него
+	if _, err := io.ReadFull(g.out, data); err != nil {
+		return nil, err
+	}
+	g.cache[hash] = data[:size]
+	return data[:size], nil
+}
+
+func (g *gitObjects) Close() error {
+	g.in.Close()
+	return g.cmd.Wait()
+}
+
+// ❌ Текст ошибки git (stderr, если есть)
+func gitError(err error) error {
+	if exit, ok := err.(*exec.ExitError); ok && len(exit.Stderr) > 0 {
+		return fmt.Errorf("%s", strings.TrimSpace(string(exit.Stderr)))
+	}
+	return err
+}
@@ -0,0 +1,292 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os/exec"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 🟩 Карта активности: badges/heatmap.svg и текстовая сетка для Telegram
+const (
+	heatmapFile  = "heatmap.svg"
+	heatmapWeeks = 26
+)
+
+// 📅 Активность за день
+type ActivityDay struct {
+	Date    string
+	Lines   int // Добавлено + удалено строк в .go файлах
+	Commits int
+	XP      int // XP из истории stats.json
+}
+
+// 🎨 Уровни интенсивности: от «ничего» до «максимум», один цвет от светлого
+// к тёмному — зелёные GitHub в SVG, плотность штриховки в тексте
+var (
+	heatmapColors = []string{"#ebedf0", "#9be9a8", "#40c463", "#30a14e", "#216e39"}
+	heatmapEmoji  = []string{"·", "░", "▒", "▓", "█"}
+)
+
+// 📏 Чем красить: lines (по умолчанию) или xp (heatmap_metric, HEATMAP_METRIC)
+func (tr *tracker) heatmapMetric() string {
+	return tr.config.HeatmapMetric
+}
+
+// 💻 Команда heatmap: текстовая сетка в консоль и SVG в badges/
+func (tr *tracker) runHeatmap(args []string) {
+	flags := flag.NewFlagSet("heatmap", flag.ExitOnError)
+	weeks := flags.Int("weeks", heatmapWeeks, tr.T("heatmap.weeks_flag"))
+	metric := flags.String("metric", tr.heatmapMetric(), tr.T("heatmap.metric_flag"))
+	flags.Parse(args)
+
+	if *metric != "xp" {
+		*metric = "lines"
+	}
+	if *weeks < 1 {
+		*weeks = heatmapWeeks
+	}
+
+	now := tr.clock.Now()
+	activity := tr.collectActivity(tr.loadStats(), heatmapStart(now, *weeks))
+
+	fmt.Println(tr.renderHeatmapEmoji(activity, *metric, now, *weeks))
+
+	path := tr.writeHeatmapSVG(activity, *metric, now, *weeks)
+	if path != "" {
+		fmt.Println(tr.T("render.done", path))
+	}
+}
+
+// 🧮 Активность по дням: строки из git log + XP из истории
+func (tr *tracker) collectActivity(stats store.UserStats, since time.Time) map[string]*ActivityDay {
+	activity := tr.activity(tr, since)
+
+	for i, point := range stats.History {
+		xp := point.XPTopics + point.XPStreak + point.XPAchievements + point.XPSeason - point.XPPenalty
+		if point.Runs == 0 && i > 0 {
+			// Старые точки без разбивки — по изменению TotalXP
+			xp = point.TotalXP - stats.History[i-1].TotalXP
+		}
+
+		if point.Date < since.Format("2006-01-02") {
+			continue
+		}
+		day := activityDay(activity, point.Date)
+		day.XP += xp
+	}
+
+	return activity
+}
+
+func activityDay(activity map[string]*ActivityDay, date string) *ActivityDay {
+	day, ok := activity[date]
+	if !ok {
+		day = &ActivityDay{Date: date}
+		activity[date] = day
+	}
+	return day
+}
+
+// 📜 Строки и коммиты по дням из git log (без git — пустая карта)
+func (tr *tracker) gitActivity(since time.Time) map[string]*ActivityDay {
+	activity := map[string]*ActivityDay{}
+
+	cmd := exec.Command("git", "log", "--no-merges", "--date=short", "--format=@%ad",
+		"--numstat", "--since="+since.Format("2006-01-02"), "--", "*.go")
+	output, err := cmd.Output()
+	if err != nil {
+		return activity
+	}
+
+	var day *ActivityDay
+	counted := false
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "@") {
+			day = activityDay(activity, strings.TrimPrefix(line, "@"))
+			counted = false
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		if day == nil || len(fields) != 3 || !tr.isLearningFile(fields[2]) {
+			continue
+		}
+
+		added, _ := strconv.Atoi(fields[0])
+		deleted, _ := strconv.Atoi(fields[1])
+		day.Lines += added + deleted
+		if !counted {
+			day.Commits++
+			counted = true
+		}
+	}
+
+	return activity
+}
+
+// 🔢 Значение дня по выбранной метрике
+func activityValue(day *ActivityDay, metric string) int {
+	if day == nil {
+		return 0
+	}
+	if metric == "xp" {
+		return day.XP
+	}
+	return day.Lines
+}
+
+// 🎚️ Уровень 0-4 относительно максимума за период (как у GitHub)
+func activityLevel(value, max int) int {
+	if value <= 0 || max <= 0 {
+		return 0
+	}
+	level := (value*4 + max - 1) / max
+	if level > 4 {
+		level = 4
+	}
+	return level
+}
+
+// 📆 Первый день сетки: понедельник weeks-1 недель назад
+func heatmapStart(now time.Time, weeks int) time.Time {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	weekday := (int(today.Weekday()) + 6) % 7 // 0 — понедельник
+	return today.AddDate(0, 0, -weekday-7*(weeks-1))
+}
+
+// 📆 Сколько недель сетки нужно, чтобы в неё попал день from
+func heatmapWeeksSince(now, from time.Time) int {
+	weeks := 1
+	for heatmapStart(now, weeks).After(from) {
+		weeks++
+	}
+	return weeks
+}
+
+// 📈 Максимум по метрике в окне сетки
+func activityMax(activity map[string]*ActivityDay, metric string, start, end time.Time) int {
+	max := 0
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		if value := activityValue(activity[day.Format("2006-01-02")], metric); value > max {
+			max = value
+		}
+	}
+	return max
+}
+
+// 🟩 Текстовая сетка: строка — неделя (пн…вс), последняя неделя внизу
+func (tr *tracker) renderHeatmapEmoji(activity map[string]*ActivityDay, metric string, now time.Time, weeks int) string {
+	start := heatmapStart(now, weeks)
+	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	max := activityMax(activity, metric, start, end)
+
+	var grid strings.Builder
+	for week := 0; week < weeks; week++ {
+		for weekday := 0; weekday < 7; weekday++ {
+			day := start.AddDate(0, 0, week*7+weekday)
+			if day.After(end) {
+				break
+			}
+			level := activityLevel(activityValue(activity[day.Format("2006-01-02")], metric), max)
+			grid.WriteString(heatmapEmoji[level])
+		}
+		grid.WriteString("\n")
+	}
+	grid.WriteString(tr.T("heatmap.legend_"+metric, strings.Join(heatmapEmoji, ""), max))
+
+	return grid.String()
+}
+
+// 🖼️ SVG в стиле GitHub: колонка — неделя, строка — день недели
+func (tr *tracker) renderHeatmapSVG(activity map[string]*ActivityDay, metric string, now time.Time, weeks int) string {
+	const (
+		cell   = 11
+		gap    = 3
+		left   = 28
+		top    = 18
+		bottom = 8
+	)
+
+	start := heatmapStart(now, weeks)
+	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	max := activityMax(activity, metric, start, end)
+
+	width := left + weeks*(cell+gap)
+	height := top + 7*(cell+gap) + bottom
+
+	var svg strings.Builder
+	svg.WriteString(fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" role="img" aria-label="%s">`, width, height, tr.T("heatmap.title")))
+	svg.WriteString(fmt.Sprintf(`<title>%s</title>`, tr.T("heatmap.title")))
+	svg.WriteString(`<g font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="9" fill="#57606a">`)
+	for weekday, label := range []string{tr.T("heatmap.mon"), tr.T("heatmap.wed"), tr.T("heatmap.fri")} {
+		y := top + (weekday*2)*(cell+gap) + cell - 1
+		svg.WriteString(fmt.Sprintf(`<text x="0" y="%d">%s</text>`, y, label))
+	}
+
+	lastMonth := -1
+	for week := 0; week < weeks; week++ {
+		monday := start.AddDate(0, 0, week*7)
+		if int(monday.Month()) != lastMonth {
+			lastMonth = int(monday.Month())
+			svg.WriteString(fmt.Sprintf(`<text x="%d" y="10">%s</text>`, left+week*(cell+gap), tr.T(fmt.Sprintf("heatmap.month.%d", lastMonth))))
+		}
+	}
+	svg.WriteString(`</g>`)
+
+	for week := 0; week < weeks; week++ {
+		for weekday := 0; weekday < 7; weekday++ {
+			day := start.AddDate(0, 0, week*7+weekday)
+			if day.After(end) {
+				break
+			}
+			date := day.Format("2006-01-02")
+			value := activityValue(activity[date], metric)
+			svg.WriteString(fmt.Sprintf(`<rect x="%d" y="%d" width="%d" height="%d" rx="2" fill="%s"><title>%s</title></rect>`,
+				left+week*(cell+gap), top+weekday*(cell+gap), cell, cell,
+				heatmapColors[activityLevel(value, max)], tr.T("heatmap.cell_"+metric, date, value)))
+		}
+	}
+
+	svg.WriteString(`</svg>`)
+	return svg.String()
+}
+
+// 💾 badges/heatmap.svg; возвращает путь или "" при ошибке
+func (tr *tracker) writeHeatmapSVG(activity map[string]*ActivityDay, metric string, now time.Time, weeks int) string {
+	if err := tr.fs.MkdirAll(badgesDir, 0755); err != nil {
+		fmt.Println(tr.T("badges.mkdir_error", badgesDir, err))
+		slog.Error("directory not created", "path", badgesDir, "err", err)
+		return ""
+	}
+
+	file := path.Join(badgesDir, heatmapFile)
+	if err := tr.fs.WriteFile(file, []byte(tr.renderHeatmapSVG(activity, metric, now, weeks)), 0644); err != nil {
+		fmt.Println(tr.T("file.write_error", file, err))
+		slog.Error("file not written", "path", file, "err", err)
+		return ""
+	}
+	return file
+}
+
+// 📝 Секция README: картинка карты активности
+func (tr *tracker) renderHeatmapSection(stats store.UserStats) string {
+	now := tr.clock.Now()
+	activity := tr.collectActivity(stats, heatmapStart(now, heatmapWeeks))
+
+	path := tr.writeHeatmapSVG(activity, tr.heatmapMetric(), now, heatmapWeeks)
+	if path == "" {
+		return ""
+	}
+	return fmt.Sprintf("![%s](%s)", tr.T("heatmap.title"), path)
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/yourusername/go-learning-tracker/tracker/clock"
+)
+
+// 🎚️ Границы уровней: четверти от максимума, округление вверх
+func TestActivityLevel(t *testing.T) {
+	tests := []struct {
+		value int
+		max   int
+		want  int
+	}{
+		{0, 100, 0},
+		{-5, 100, 0},
+		{5, 0, 0},
+		{1, 100, 1},
+		{25, 100, 1},
+		{26, 100, 2},
+		{50, 100, 2},
+		{51, 100, 3},
+		{75, 100, 3},
+		{76, 100, 4},
+		{100, 100, 4},
+		{150, 100, 4},
+		{1, 1, 4},
+		{2, 8, 1},
+		{3, 8, 2},
+		{6, 8, 3},
+		{7, 8, 4},
+	}
+
+	for _, tt := range tests {
+		if got := activityLevel(tt.value, tt.max); got != tt.want {
+			t.Errorf("activityLevel(%d, %d) = %d, want %d", tt.value, tt.max, got, tt.want)
+		}
+	}
+	if len(heatmapEmoji) != 5 || len(heatmapColors) != 5 {
+		t.Errorf("scale: %d glyphs, %d colours, want 5", len(heatmapEmoji), len(heatmapColors))
+	}
+}
+
+// 🟩 Сетка с понедельника; максимум — только по дням окна
+func TestRenderHeatmap(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-04", nil)
+	now := clock.At("2026-03-04").Now() // среда
+	activity := map[string]*ActivityDay{
+		"2026-02-22": {Lines: 100}, // воскресенье до окна
+		"2026-02-23": {Lines: 10},
+		"2026-02-25": {Lines: 1},
+		"2026-03-01": {Lines: 5},
+		"2026-03-04": {Lines: 7, XP: 30},
+		"2026-03-05": {Lines: 50}, // завтра
+	}
+
+	want := "█·░···▒\n··▓\n" + tr.T("heatmap.legend_lines", "·░▒▓█", 10)
+	if got := tr.renderHeatmapEmoji(activity, "lines", now, 2); got != want {
+		t.Errorf("lines grid:\n%s\nwant:\n%s", got, want)
+	}
+	if got := tr.renderHeatmapEmoji(activity, "xp", now, 1); got != "··█\n"+tr.T("heatmap.legend_xp", "·░▒▓█", 30) {
+		t.Errorf("xp grid:\n%s", got)
+	}
+
+	svg := tr.renderHeatmapSVG(activity, "lines", now, 2)
+	if got := strings.Count(svg, "<rect "); got != 10 {
+		t.Errorf("SVG cells = %d, want 10", got)
+	}
+	for _, cell := range []string{
+		`fill="#216e39"><title>` + tr.T("heatmap.cell_lines", "2026-02-23", 10),
+		`fill="#9be9a8"><title>` + tr.T("heatmap.cell_lines", "2026-02-25", 1),
+		`fill="#30a14e"><title>` + tr.T("heatmap.cell_lines", "2026-03-04", 7),
+		`fill="#ebedf0"><title>` + tr.T("heatmap.cell_lines", "2026-03-03", 0),
+	} {
+		if !strings.Contains(svg, cell) {
+			t.Errorf("SVG cell not found: %s", cell)
+		}
+	}
+}
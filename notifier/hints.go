@@ -0,0 +1,215 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/yourusername/go-learning-tracker/tracker/analyzer"
+)
+
+// 📚 Каталог с банком подсказок (JSON-файлы, их могут дополнять менторы)
+// Переводы лежат в hints/<язык>/ и дополняют или заменяют записи основного банка
+const hintsDir = "hints"
+
+// 💡 Упражнение из банка подсказок
+type Exercise struct {
+	Title string `json:"title"`
+	Task  string `json:"task"`
+}
+
+// 📖 Запись банка подсказок для одной темы
+type HintEntry struct {
+	Topic     string            `json:"topic"`  // ID темы из syllabus
+	Labels    map[string]string `json:"labels"` // Человекочитаемые названия ключевых слов/паттернов
+	Exercises []Exercise        `json:"exercises"`
+}
+
+// 🎯 Подсказка по следующей теме
+type Hint struct {
+	Topic    string
+	Missing  int
+	Have     int
+	Need     int
+	Details  []string // "каналов (chan): 3"
+	Katas    []string // Непройденные упражнения из exercises/
+	Review   *Review  // Тема ждёт ментора (Status пустой) или отклонена
+	Exercise *Exercise
+}
+
+// 📥 Загрузка банка подсказок из всех hints/*.json
+// Записи для одной темы из разных файлов объединяются; для языка, отличного
+// от основного, упражнения из hints/<язык>/ заменяют основные
+func (tr *tracker) loadHintBank(dir string) map[string]HintEntry {
+	bank := tr.loadHintFiles(path.Join(dir, "*.json"))
+	if tr.lang == defaultLang {
+		return bank
+	}
+
+	for topic, translated := range tr.loadHintFiles(path.Join(dir, tr.lang, "*.json")) {
+		entry, ok := bank[topic]
+		if !ok {
+			bank[topic] = translated
+			continue
+		}
+		for pattern, label := range translated.Labels {
+			entry.Labels[pattern] = label
+		}
+		if len(translated.Exercises) > 0 {
+			entry.Exercises = translated.Exercises
+		}
+		bank[topic] = entry
+	}
+
+	return bank
+}
+
+// 📂 Чтение файлов банка по шаблону
+func (tr *tracker) loadHintFiles(pattern string) map[string]HintEntry {
+	bank := map[string]HintEntry{}
+
+	files, _ := fs.Glob(tr.fs, pattern)
+	sort.Strings(files)
+
+	for _, file := range files {
+		data, err := fs.ReadFile(tr.fs, file)
+		if err != nil {
+			continue
+		}
+
+		var entries []HintEntry
+		if err := json.Unmarshal(data, &entries); err != nil {
+			fmt.Println(tr.T("hints.corrupt", file, err))
+			slog.Warn("corrupt hint bank", "file", file, "err", err)
+			continue
+		}
+
+		for _, entry := range entries {
+			existing, ok := bank[entry.Topic]
+			if !ok {
+				existing = HintEntry{Topic: entry.Topic, Labels: map[string]string{}}
+			}
+			for pattern, label := range entry.Labels {
+				existing.Labels[pattern] = label
+			}
+			existing.Exercises = append(existing.Exercises, entry.Exercises...)
+			bank[entry.Topic] = existing
+		}
+	}
+
+	return bank
+}
+
+// 🧭 Построение подсказки для темы
+// seed выбирает упражнение, чтобы подсказки менялись от запуска к запуску
+func (tr *tracker) buildHint(topic analyzer.Topic, bank map[string]HintEntry, seed int) Hint {
+	hint := Hint{
+		Topic: tr.topicName(topic),
+		Have:  topic.Found,
+		Need:  topic.MinExamples,
+	}
+	if topic.Found < topic.MinExamples {
+		hint.Missing = topic.MinExamples - topic.Found
+	}
+
+	entry := bank[topic.ID]
+
+	patterns := append(append([]string{}, topic.Keywords...), topic.Detectors...)
+	for _, pattern := range patterns {
+		label := entry.Labels[pattern]
+		if label == "" {
+			label = fmt.Sprintf("'%s'", strings.TrimSpace(pattern))
+		}
+		hint.Details = append(hint.Details, fmt.Sprintf("%s: %d", label, topic.Counts[pattern]))
+	}
+
+	if len(entry.Exercises) > 0 {
+		if seed < 0 {
+			seed = -seed
+		}
+		exercise := entry.Exercises[seed%len(entry.Exercises)]
+		hint.Exercise = &exercise
+	}
+
+	return hint
+}
+
+// 📝 Текст подсказки (общий для Telegram и CLI)
+func (tr *tracker) formatHint(hint Hint) string {
+	var text strings.Builder
+
+	if hint.Missing > 0 {
+		text.WriteString(tr.T("hints.missing", hint.Missing, hint.Have, hint.Need) + "\n")
+	} else {
+		text.WriteString(tr.T("hints.enough", hint.Have, hint.Need) + "\n")
+	}
+
+	if len(hint.Details) > 0 {
+		text.WriteString(tr.T("hints.have", strings.Join(hint.Details, "; ")) + "\n")
+	}
+
+	for _, kata := range hint.Katas {
+		text.WriteString(tr.T("hints.kata", kata) + "\n")
+	}
+
+	if hint.Review != nil {
+		if hint.Review.Status == reviewRejected {
+			text.WriteString(tr.T("hints.review_rejected", hint.Review.Mentor, hint.Review.Comment) + "\n")
+		} else {
+			text.WriteString(tr.T("hints.review_pending") + "\n")
+		}
+	}
+
+	if hint.Exercise != nil {
+		text.WriteString(tr.T("hints.exercise", hint.Exercise.Title) + "\n")
+		text.WriteString(fmt.Sprintf("   %s\n", hint.Exercise.Task))
+	}
+
+	return text.String()
+}
+
+// 🔍 Следующая неизученная тема (nil — всё изучено)
+func (tr *tracker) nextIncompleteTopic() *analyzer.Topic {
+	for i := range tr.syllabus {
+		if !tr.syllabus[i].Completed() {
+			return &tr.syllabus[i]
+		}
+	}
+	return nil
+}
+
+// 💻 Команда hint: подсказка без изменения статистики
+func (tr *tracker) runHint() {
+	stats := tr.loadStats()
+
+	if tr.analyzeCodebase(false) == 0 {
+		fmt.Println(tr.T("run.no_go_files"))
+		return
+	}
+
+	var kataResults []KataResult
+	if tr.kataModeEnabled() {
+		for _, kata := range tr.discoverKatas(exercisesDir) {
+			kataResults = append(kataResults, runKata(kata))
+		}
+		tr.applyKataResults(kataResults)
+	}
+
+	reviews := tr.holdTopics(stats)
+
+	topic := tr.nextIncompleteTopic()
+	if topic == nil {
+		fmt.Println(tr.T("run.all_done"))
+		return
+	}
+
+	hint := tr.buildHint(*topic, tr.loadHintBank(hintsDir), stats.TotalCommits)
+	hint.Katas = pendingKatas(topic.ID, kataResults)
+	hint.Review = topicReview(*topic, reviews)
+	fmt.Println(tr.T("report.next_goal", tr.topicName(*topic)))
+	fmt.Print(tr.formatHint(hint))
+}
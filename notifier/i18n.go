@@ -0,0 +1,127 @@
+package main
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/yourusername/go-learning-tracker/tracker/achievements"
+	"github.com/yourusername/go-learning-tracker/tracker/analyzer"
+)
+
+// 🌍 Каталоги сообщений: locales/<язык>.json (ключ → формат для fmt)
+//
+//go:embed locales/*.json
+var localeFiles embed.FS
+
+// 🇷🇺 Язык по умолчанию и запасной, если ключа нет в выбранном
+const defaultLang = "ru"
+
+var catalogues = loadCatalogues()
+
+// 📥 Загрузка всех встроенных каталогов
+func loadCatalogues() map[string]map[string]string {
+	result := map[string]map[string]string{}
+
+	files, _ := localeFiles.ReadDir("locales")
+	for _, file := range files {
+		data, err := localeFiles.ReadFile("locales/" + file.Name())
+		if err != nil {
+			continue
+		}
+
+		var messages map[string]string
+		if err := json.Unmarshal(data, &messages); err != nil {
+			panic(fmt.Sprintf("locales/%s: %v", file.Name(), err))
+		}
+		result[strings.TrimSuffix(file.Name(), path.Ext(file.Name()))] = messages
+	}
+
+	return result
+}
+
+// 🗣️ Выбор языка (пустая строка — язык по умолчанию)
+func (tr *tracker) setLanguage(lang string) {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if lang == "" {
+		lang = defaultLang
+	}
+
+	if _, ok := catalogues[lang]; !ok {
+		fmt.Printf("⚠️ Unknown language %q, available: %s\n", lang, strings.Join(availableLanguages(), ", "))
+		lang = defaultLang
+	}
+	tr.lang = lang
+}
+
+// 📋 Доступные языки
+func availableLanguages() []string {
+	var langs []string
+	for lang := range catalogues {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// 🔤 Перевод сообщения; args подставляются как в fmt.Sprintf
+func (tr *tracker) T(key string, args ...interface{}) string {
+	format, ok := catalogues[tr.lang][key]
+	if !ok {
+		format, ok = catalogues[defaultLang][key]
+	}
+	if !ok {
+		return key
+	}
+
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// 📚 Отображаемое название темы
+func (tr *tracker) topicName(t analyzer.Topic) string {
+	return tr.T("topic." + t.ID)
+}
+
+// 🏆 Достижение с текстами на текущем языке
+func (tr *tracker) localizeAchievement(a achievements.Achievement, rules achievements.Rules) achievements.Achievement {
+	a.Name = tr.T("achievement." + a.ID + ".name")
+	a.Description = tr.T("achievement." + a.ID + ".description")
+	a.Tip = tr.T("achievement." + a.ID + ".tip")
+	// В подсказке к уровню — минимум коммитов из настроек
+	var level int
+	if _, err := fmt.Sscanf(a.ID, "level_%d", &level); err == nil {
+		a.Tip = tr.T("achievement."+a.ID+".tip", rules.LevelCommits[level])
+	}
+	return a
+}
+
+// 🏆 Все достижения на текущем языке
+func (tr *tracker) localizedAchievements() []achievements.Achievement {
+	result := achievements.All()
+	rules := tr.config.Rules().Achievements
+	for i, ach := range result {
+		result[i] = tr.localizeAchievement(ach, rules)
+	}
+	return result
+}
+
+// 🔁 ID темы по старому названию из .completed_topics (до появления ID)
+func (tr *tracker) topicIDByLegacyName(name string) (string, bool) {
+	for _, topic := range tr.syllabus {
+		if topic.ID == name {
+			return topic.ID, true
+		}
+		for _, messages := range catalogues {
+			if messages["topic."+topic.ID] == name {
+				return topic.ID, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,50 @@
+package main
+
+import "testing"
+
+// 🌍 Ключа нет в выбранном языке — берётся русский; нет нигде — сам ключ
+func TestTranslateFallback(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", nil)
+	catalogues["ru"]["test.only_ru"] = "только по-русски: %d"
+	t.Cleanup(func() { delete(catalogues["ru"], "test.only_ru") })
+
+	tests := []struct {
+		name string
+		lang string
+		key  string
+		args []interface{}
+		want string
+	}{
+		{"en", "en", "badge.streak_days", []interface{}{3}, "3 days"},
+		{"ru", "ru", "badge.streak_days", []interface{}{3}, "3 дней"},
+		{"нет в en", "en", "test.only_ru", []interface{}{7}, "только по-русски: 7"},
+		{"неизвестный ключ", "en", "test.missing", []interface{}{7}, "test.missing"},
+		{"неизвестный язык", "xx", "badge.streak_days", []interface{}{3}, "3 дней"},
+		{"без аргументов", "en", "badge.max_level", nil, "max"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr.setLanguage(tt.lang)
+			if got := tr.T(tt.key, tt.args...); got != tt.want {
+				t.Errorf("T(%q) = %q, want %q", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+// 🔑 Каталоги переводов с одинаковым набором ключей
+func TestCataloguesComplete(t *testing.T) {
+	for lang, messages := range catalogues {
+		for key := range catalogues[defaultLang] {
+			if _, ok := messages[key]; !ok {
+				t.Errorf("%s: missing key %q", lang, key)
+			}
+		}
+		for key := range messages {
+			if _, ok := catalogues[defaultLang][key]; !ok {
+				t.Errorf("%s: extra key %q", lang, key)
+			}
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os/exec"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/yourusername/go-learning-tracker/tracker/analyzer"
+	"github.com/yourusername/go-learning-tracker/tracker/integrity"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 🚩 INTEGRITY: признаки накрутки в истории git
+// Запуск проверяет коммиты, появившиеся с прошлого (stats.LastSeenCommit):
+// задним числом, с кучей новых файлов, с кучей изученных тем — и не
+// переписана ли история. Темы, изученные в запуске с подозрением, ждут
+// одобрения ментора (review approve) — до него XP за них не начисляется
+// (engine.Hold).
+// Коммиты для достижений считаются по git, а не по запускам трекера — с
+// integrity и без.
+
+// 🔛 integrity: true (TRACKER_INTEGRITY=1) включает проверку
+func (tr *tracker) integrityEnabled() bool {
+	return tr.config.Integrity
+}
+
+func (tr *tracker) integrityRules() integrity.Rules {
+	return integrity.Rules{
+		BackdateHours: tr.config.IntegrityBackdateHours,
+		MaxFiles:      tr.config.IntegrityMaxFiles,
+		MaxTopics:     tr.config.IntegrityMaxTopics,
+	}
+}
+
+// 📜 Новые коммиты ученика с прошлого запуска
+type commitRange struct {
+	Head      string
+	Commits   []integrity.Commit
+	Rewritten bool // Коммит прошлого запуска — не предок HEAD
+	Shallow   bool // Неполный клон: виден только HEAD, проверять нечего
+}
+
+// ❌ В неполном клоне старых коммитов нет — переписанную историю не отличить
+var errShallowClone = errors.New("shallow clone, fetch-depth: 0 needed")
+
+// 🔍 Проверка новых коммитов: сколько их, подозрения запуска и последний
+// проверенный коммит (stats.LastSeenCommit). Коммиты считаются по git и без
+// integrity: запуск по cron или вручную без новых коммитов — не коммит.
+// Без git (или в неполном клоне) новых коммитов 0
+func (tr *tracker) auditCommits(stats *store.UserStats, now time.Time) (int, []integrity.Flag) {
+	commits, err := tr.commits(tr, stats.LastSeenCommit)
+	if err != nil {
+		fmt.Println(tr.T("run.commits_error", err))
+		slog.Warn("commits not counted", "learner", stats.Username, "err", err)
+		return 0, nil
+	}
+	if !tr.integrityEnabled() {
+		stats.LastSeenCommit = commits.Head
+		return len(commits.Commits), nil
+	}
+	if commits.Shallow {
+		fmt.Println(tr.T("integrity.git_error", errShallowClone))
+		slog.Warn("integrity check skipped", "learner", stats.Username, "err", errShallowClone)
+		stats.LastSeenCommit = commits.Head
+		return len(commits.Commits), nil
+	}
+
+	flags := integrity.Check(commits.Commits, tr.integrityRules())
+	if commits.Rewritten {
+		flags = append([]integrity.Flag{{Kind: integrity.Rewritten, Commit: integrity.Short(stats.LastSeenCommit)}}, flags...)
+	}
+	for i := range flags {
+		flags[i].Date = now.Format("2006-01-02")
+		fmt.Println(tr.T("integrity."+flags[i].Kind, flags[i].Commit, flags[i].Value))
+		slog.Warn("suspicious commit", "learner", stats.Username, "kind", flags[i].Kind,
+			"commit", flags[i].Commit, "value", flags[i].Value)
+	}
+
+	stats.LastSeenCommit = commits.Head
+	return len(commits.Commits), flags
+}
+
+// 📜 Коммиты ученика с учебным кодом после last (пусто — первый запуск,
+// только последний). Если last не предок HEAD, история переписана — тоже
+// проверяется только последний. В неполном клоне (fetch-depth: 1) истории
+// нет: новый HEAD — один коммит
+func (tr *tracker) gitCommitsSince(last string) (commitRange, error) {
+	output, err := exec.Command("git", "rev-parse", "--is-shallow-repository", "HEAD").Output()
+	if err != nil {
+		return commitRange{}, gitError(err)
+	}
+	lines := strings.Fields(string(output))
+	if len(lines) != 2 {
+		return commitRange{}, fmt.Errorf("git rev-parse: %q", output)
+	}
+	result := commitRange{Head: lines[1], Shallow: lines[0] == "true"}
+	if last == result.Head {
+		return result, nil
+	}
+	revs := []string{"-1", result.Head}
+	if last != "" && !result.Shallow {
+		if exec.Command("git", "merge-base", "--is-ancestor", last, result.Head).Run() == nil {
+			revs = []string{last + ".." + result.Head}
+		} else {
+			result.Rewritten = true
+		}
+	}
+
+	args := append([]string{"log", "--reverse", "--no-merges", "--format=%x00%H %P %aI %cI", "--name-status"}, revs...)
+	output, err = exec.Command("git", append(args, "--", path.Join(tr.dir, "*.go"))...).Output()
+	if err != nil {
+		return commitRange{}, gitError(err)
+	}
+
+	objects, err := openGitObjects()
+	if err != nil {
+		return commitRange{}, err
+	}
+	defer objects.Close()
+
+	completed := map[string]map[string]bool{} // Коммит → изученные темы
+	for _, record := range strings.Split(string(output), "\x00")[1:] {
+		lines := strings.Split(strings.TrimSpace(record), "\n")
+		// <hash> [<parent>] <author date> <commit date>
+		fields := strings.Fields(lines[0])
+		if len(fields) < 3 {
+			continue
+		}
+		commit := integrity.Commit{Hash: fields[0]}
+		parent := ""
+		if len(fields) == 4 {
+			parent = fields[1]
+		}
+		if commit.AuthorDate, err = time.Parse(time.RFC3339, fields[len(fields)-2]); err != nil {
+			return commitRange{}, err
+		}
+		if commit.CommitDate, err = time.Parse(time.RFC3339, fields[len(fields)-1]); err != nil {
+			return commitRange{}, err
+		}
+
+		// <статус>\t<путь>: коммиты без учебного кода (статистика, трекер) не считаются
+		learning := false
+		for _, line := range lines[1:] {
+			status, file, ok := strings.Cut(line, "\t")
+			if !ok || !tr.isLearningFile(file) {
+				continue
+			}
+			learning = true
+			if status == "A" {
+				commit.AddedFiles++
+			}
+		}
+		if !learning {
+			continue
+		}
+
+		for _, hash := range []string{parent, commit.Hash} {
+			if _, ok := completed[hash]; ok {
+				continue
+			}
+			if completed[hash], err = tr.completedAt(objects, hash); err != nil {
+				return commitRange{}, err
+			}
+		}
+		for _, topic := range tr.syllabus {
+			if completed[commit.Hash][topic.ID] && !completed[parent][topic.ID] {
+				commit.Topics = append(commit.Topics, topic.ID)
+			}
+		}
+
+		result.Commits = append(result.Commits, commit)
+	}
+	return result, nil
+}
+
+// 📚 Темы, изученные в дереве коммита (пусто — корневой коммит без родителя)
+func (tr *tracker) completedAt(objects *gitObjects, commit string) (map[string]bool, error) {
+	completed := map[string]bool{}
+	if commit == "" {
+		return completed, nil
+	}
+
+	files, err := tr.learningGoBlobs(commit)
+	if err != nil {
+		return nil, err
+	}
+	fsys, err := blobFS(objects, files, nil)
+	if err != nil {
+		return nil, err
+	}
+	topics := make([]analyzer.Topic, len(tr.syllabus))
+	copy(topics, tr.syllabus)
+	analyzer.Analyze(topics, fsys, sortedPaths(files))
+	for _, topic := range topics {
+		if topic.Found >= topic.MinExamples {
+			completed[topic.ID] = true
+		}
+	}
+	return completed, nil
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yourusername/go-learning-tracker/tracker/analyzer"
+	"github.com/yourusername/go-learning-tracker/tracker/integrity"
+	"github.com/yourusername/go-learning-tracker/tracker/notify"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 🧪 Новые коммиты без git: каждый запуск получает следующий диапазон
+func useTestCommits(tr *tracker, ranges ...commitRange) *[]string {
+	var seen []string
+	tr.commits = func(_ *tracker, last string) (commitRange, error) {
+		seen = append(seen, last)
+		next := ranges[0]
+		ranges = ranges[1:]
+		return next, nil
+	}
+	return &seen
+}
+
+func testCommit(hash string, files int, topics ...string) integrity.Commit {
+	when := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	return integrity.Commit{Hash: hash, AuthorDate: when, CommitDate: when, AddedFiles: files, Topics: topics}
+}
+
+// 🚩 Подозрительный коммит придерживает темы до одобрения ментора
+func TestIntegrityHoldsTopics(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", map[string]string{"basics/conditions.go": conditionsSource})
+	memfs := tr.fs.(store.MemFS)
+	tr.config.Integrity = true
+	t.Setenv("REVIEW_SECRET", "s3cret")
+	seen := useTestCommits(tr,
+		commitRange{Head: "c1", Commits: []integrity.Commit{testCommit("c1", 1)}},
+		commitRange{Head: "c1"}, // Ручной запуск: новых коммитов нет
+		commitRange{Head: "c2", Commits: []integrity.Commit{testCommit("c2", 1), testCommit("c3", 12, "types", "variables")}},
+		commitRange{Head: "c4", Rewritten: true},
+		commitRange{Head: "c4"},
+	)
+
+	day1 := trackOn(t, tr, "2026-03-01")
+	if day1.Stats.TotalCommits != 1 || day1.Stats.LastSeenCommit != "c1" || len(day1.IntegrityFlags) != 0 {
+		t.Fatalf("day 1: %+v", day1.Stats)
+	}
+	day2 := trackOn(t, tr, "2026-03-02")
+	if day2.Stats.TotalCommits != 1 {
+		t.Errorf("manual run counted as a commit: %d", day2.Stats.TotalCommits)
+	}
+
+	memfs.WriteFile("basics/main.go", []byte(basicsSource), 0644)
+	day3 := trackOn(t, tr, "2026-03-03")
+	if day3.Stats.TotalCommits != 3 || len(day3.IntegrityFlags) != 1 || day3.IntegrityFlags[0].Kind != integrity.BulkFiles {
+		t.Fatalf("day 3: commits %d, flags %+v", day3.Stats.TotalCommits, day3.IntegrityFlags)
+	}
+	if day3.XP.Topics != 0 || len(day3.PendingReview) != 2 || day3.Completed != 0 {
+		t.Errorf("day 3: topics XP %d, pending %v", day3.XP.Topics, day3.PendingReview)
+	}
+	if flags := day3.Stats.IntegrityFlags; len(flags) != 1 || strings.Join(flags[0].Topics, ",") != "types,variables" || flags[0].Date != "2026-03-03" {
+		t.Errorf("saved flags: %+v", flags)
+	}
+	console := notify.Apply(tr.renderReport("console", day3), notify.Console)
+	if !strings.Contains(console, tr.T("integrity.bulk_files", "c3", 12)) {
+		t.Errorf("report has no integrity flag:\n%s", console)
+	}
+
+	// Ментор одобрил одну тему — она засчитывается, вторая ждёт дальше
+	if _, err := tr.addReview("alice", "types", reviewApproved, "bob", ""); err != nil {
+		t.Fatal(err)
+	}
+	day4 := trackOn(t, tr, "2026-03-04")
+	if day4.XP.Topics != 50 || len(day4.PendingReview) != 1 || day4.IntegrityFlags[0].Kind != integrity.Rewritten {
+		t.Errorf("day 4: topics XP %d, pending %v, flags %+v", day4.XP.Topics, day4.PendingReview, day4.IntegrityFlags)
+	}
+	// Незасчитанные темы придержаны и новым флагом, одобрение действует и на него
+	if flag := day4.IntegrityFlags[0]; flag.Commit != "c2" || strings.Join(flag.Topics, ",") != "types,variables" {
+		t.Errorf("rewritten flag: %+v", day4.IntegrityFlags[0])
+	}
+	if completed := string(memfs[store.CompletedFile].Data); completed != `["types"]` {
+		t.Errorf("%s = %s", store.CompletedFile, completed)
+	}
+
+	// Отказ по придержанной теме попадает в отчёт и без review mode
+	if _, err := tr.addReview("alice", "variables", reviewRejected, "bob", "свои примеры"); err != nil {
+		t.Fatal(err)
+	}
+	day5 := trackOn(t, tr, "2026-03-05")
+	if len(day5.Rejections) != 1 || day5.Rejections[0].Topic != "variables" || len(day5.PendingReview) != 1 {
+		t.Errorf("day 5: rejections %+v, pending %v", day5.Rejections, day5.PendingReview)
+	}
+
+	if got := strings.Join(*seen, ","); got != ",c1,c1,c2,c4" {
+		t.Errorf("LastSeenCommit passed: %q", got)
+	}
+}
+
+// 🔒 Придержанные темы не засчитываются и вне запуска трекера: бот,
+// список review и дашборд берут флаги из stats.json
+func TestIntegrityHeldOutsideRun(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", map[string]string{"basics/main.go": basicsSource})
+	tr.config.Integrity = true
+	t.Setenv("REVIEW_SECRET", "s3cret")
+	useTestCommits(tr, commitRange{Head: "c1", Commits: []integrity.Commit{testCommit("c1", 12)}})
+	trackOn(t, tr, "2026-03-01")
+
+	tr.syllabus = analyzer.DefaultSyllabus()
+	if completed := tr.botAnalyze(tr.loadStats()); completed != 0 {
+		t.Errorf("bot: %d topics completed", completed)
+	}
+	if pending := tr.formatPendingReviews(); !strings.Contains(pending, "(types)") || !strings.Contains(pending, "(variables)") {
+		t.Errorf("review list:\n%s", pending)
+	}
+
+	if _, err := tr.addReview("alice", "types", reviewApproved, "bob", ""); err != nil {
+		t.Fatal(err)
+	}
+	tr.syllabus = analyzer.DefaultSyllabus()
+	if data := tr.buildDashboardData(&leaderboardCache{}); data.Completed != 1 {
+		t.Errorf("dashboard: %d topics completed, want 1", data.Completed)
+	}
+}
+
+// 🔕 Без integrity коммиты тоже считаются по git: запуск без новых коммитов
+// (cron, ручной) и запуск без git коммитом не считаются
+func TestIntegrityDisabled(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", map[string]string{"basics/main.go": basicsSource})
+	useTestCommits(tr,
+		commitRange{Head: "c2", Commits: []integrity.Commit{testCommit("c1", 1), testCommit("c2", 12, "types")}},
+		commitRange{Head: "c2"},
+		commitRange{Head: "c3", Shallow: true, Commits: []integrity.Commit{testCommit("c3", 40)}},
+	)
+
+	day1 := trackOn(t, tr, "2026-03-01")
+	if day1.Stats.TotalCommits != 2 || day1.Stats.LastSeenCommit != "c2" || len(day1.IntegrityFlags) != 0 {
+		t.Errorf("day 1: %+v", day1.Stats)
+	}
+	if day2 := trackOn(t, tr, "2026-03-02"); day2.Stats.TotalCommits != 2 {
+		t.Errorf("run without commits counted: %d", day2.Stats.TotalCommits)
+	}
+	if day3 := trackOn(t, tr, "2026-03-03"); day3.Stats.TotalCommits != 3 || day3.Stats.LastSeenCommit != "c3" {
+		t.Errorf("shallow clone: %+v", day3.Stats)
+	}
+
+	tr.commits = func(*tracker, string) (commitRange, error) { return commitRange{}, errShallowClone }
+	if day4 := trackOn(t, tr, "2026-03-04"); day4.Stats.TotalCommits != 3 || day4.Stats.LastSeenCommit != "c3" {
+		t.Errorf("git error: %+v", day4.Stats)
+	}
+}
@@ -0,0 +1,263 @@
+package main
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// 🥋 Каталог с упражнениями (kata mode): в exercises/<имя>/ ученик пишет
+// решение, а условие (exercise.json) и проверка (check_test.go) встроены в
+// трекер — katas/<имя>/. Правка или удаление файлов в exercises/ не
+// засчитывает упражнение
+const exercisesDir = "exercises"
+
+//go:embed katas
+var kataFiles embed.FS
+
+// 🧪 Файл скрытых тестов упражнения
+const kataCheckFile = "check_test.go"
+
+// ⏱️ Лимит на прогон тестов одного упражнения
+const kataTimeout = 2 * time.Minute
+
+// 🥋 Упражнение с автоматической проверкой
+type Kata struct {
+	Name   string            `json:"-"` // Имя каталога
+	Dir    string            `json:"-"`
+	Title  string            `json:"title"`
+	Titles map[string]string `json:"titles"` // Переводы названия: {"en": "..."}
+	Topic  string            `json:"topic"`  // ID темы из syllabus, которую закрывает упражнение
+}
+
+// 🏷️ Название упражнения на текущем языке
+func (tr *tracker) kataTitle(kata Kata) string {
+	if title, ok := kata.Titles[tr.lang]; ok {
+		return title
+	}
+	return kata.Title
+}
+
+// ✅ Результат проверки упражнения
+type KataResult struct {
+	Kata     Kata
+	Passed   bool
+	Output   string
+	Duration time.Duration
+}
+
+// 🔛 Засчитывать темы только после прохождения упражнений
+// Упражнения общие на весь репозиторий, поэтому в team mode не проверяются
+func (tr *tracker) kataModeEnabled() bool {
+	return tr.config.Kata && tr.dir == "."
+}
+
+// 🔎 Упражнения трекера: katas/<имя>/exercise.json; решение — в dir/<имя>
+func (tr *tracker) discoverKatas(dir string) []Kata {
+	var katas []Kata
+
+	files, _ := fs.Glob(kataFiles, "katas/*/exercise.json")
+	sort.Strings(files)
+
+	for _, file := range files {
+		data, err := kataFiles.ReadFile(file)
+		if err != nil {
+			continue
+		}
+
+		var kata Kata
+		if err := json.Unmarshal(data, &kata); err != nil {
+			fmt.Println(tr.T("kata.corrupt", file, err))
+			slog.Warn("corrupt kata", "file", file, "err", err)
+			continue
+		}
+
+		kata.Name = path.Base(path.Dir(file))
+		kata.Dir = filepath.Join(dir, kata.Name)
+		katas = append(katas, kata)
+	}
+
+	return katas
+}
+
+// 🧪 Прогон скрытых тестов упражнения (теги kata)
+// Тесты берутся из трекера через -overlay, а свои *_test.go ученика в
+// каталоге упражнения в сборку не попадают (иначе TestMain мог бы
+// завершить прогон успехом)
+func runKata(kata Kata) KataResult {
+	ctx, cancel := context.WithTimeout(context.Background(), kataTimeout)
+	defer cancel()
+
+	start := time.Now()
+	result := KataResult{Kata: kata}
+
+	overlay, cleanup, err := writeKataOverlay(kata)
+	if err != nil {
+		result.Output = err.Error()
+		result.Duration = time.Since(start)
+		slog.Warn("kata not checked", "kata", kata.Name, "err", err)
+		return result
+	}
+	defer cleanup()
+
+	cmd := exec.CommandContext(ctx, "go", "test", "-tags", "kata", "-count=1", "-overlay", overlay, "./"+filepath.ToSlash(kata.Dir))
+	output, err := cmd.CombinedOutput()
+
+	result.Passed = err == nil
+	result.Output = string(output)
+	result.Duration = time.Since(start)
+	return result
+}
+
+// 🗂️ Overlay для go test: check_test.go из трекера, остальные тесты в
+// каталоге упражнения убраны. Возвращает путь к overlay.json и уборку
+func writeKataOverlay(kata Kata) (string, func(), error) {
+	check, err := kataFiles.ReadFile(path.Join("katas", kata.Name, kataCheckFile))
+	if err != nil {
+		return "", nil, err
+	}
+	dir, err := filepath.Abs(kata.Dir)
+	if err != nil {
+		return "", nil, err
+	}
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return "", nil, fmt.Errorf("%s: %w", kata.Dir, fs.ErrNotExist)
+	}
+
+	tmp, err := os.MkdirTemp("", "kata-")
+	if err != nil {
+		return "", nil, err
+	}
+	cleanup := func() { os.RemoveAll(tmp) }
+
+	replace, err := kataOverlay(dir, filepath.Join(tmp, kataCheckFile))
+	if err == nil {
+		err = os.WriteFile(filepath.Join(tmp, kataCheckFile), check, 0644)
+	}
+	var data []byte
+	if err == nil {
+		data, err = json.Marshal(map[string]map[string]string{"Replace": replace})
+	}
+	overlay := filepath.Join(tmp, "overlay.json")
+	if err == nil {
+		err = os.WriteFile(overlay, data, 0644)
+	}
+	if err != nil {
+		cleanup()
+		return "", nil, err
+	}
+	return overlay, cleanup, nil
+}
+
+// 🗂️ Замены overlay: dir/check_test.go → check, другие *_test.go — удалены
+func kataOverlay(dir, check string) (map[string]string, error) {
+	replace := map[string]string{filepath.Join(dir, kataCheckFile): check}
+
+	tests, err := filepath.Glob(filepath.Join(dir, "*_test.go"))
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range tests {
+		if filepath.Base(file) != kataCheckFile {
+			replace[file] = ""
+		}
+	}
+	return replace, nil
+}
+
+// 🔒 Применение результатов: тема без пройденных упражнений не засчитывается
+func (tr *tracker) applyKataResults(results []KataResult) {
+	failed := map[string]bool{}
+	for _, result := range results {
+		if !result.Passed {
+			failed[result.Kata.Topic] = true
+		}
+	}
+
+	for i := range tr.syllabus {
+		tr.syllabus[i].KataPending = failed[tr.syllabus[i].ID]
+	}
+}
+
+// 📋 Имена пройденных упражнений
+func passedKatas(results []KataResult) []string {
+	var passed []string
+	for _, result := range results {
+		if result.Passed {
+			passed = append(passed, result.Kata.Name)
+		}
+	}
+	return passed
+}
+
+// 🥋 Упражнения, которые ещё нужно пройти для темы
+func pendingKatas(topic string, results []KataResult) []string {
+	var pending []string
+	for _, result := range results {
+		if result.Kata.Topic == topic && !result.Passed {
+			pending = append(pending, result.Kata.Name)
+		}
+	}
+	return pending
+}
+
+// 💻 Команда kata: проверка упражнений без изменения статистики
+func (tr *tracker) runKataCommand(args []string) {
+	katas := tr.discoverKatas(exercisesDir)
+	if len(katas) == 0 {
+		fmt.Println(tr.T("kata.none", exercisesDir))
+		os.Exit(exitError)
+	}
+
+	ran, failed := 0, 0
+	for _, kata := range katas {
+		if len(args) > 0 && args[0] != kata.Name {
+			continue
+		}
+		ran++
+
+		result := runKata(kata)
+		if result.Passed {
+			fmt.Println(tr.T("kata.passed", kata.Name, tr.kataTitle(kata), tr.topicDisplayName(kata.Topic), result.Duration.Seconds()))
+			continue
+		}
+
+		failed++
+		fmt.Println(tr.T("kata.failed", kata.Name, tr.kataTitle(kata), tr.topicDisplayName(kata.Topic)))
+		for _, line := range strings.Split(strings.TrimSpace(result.Output), "\n") {
+			fmt.Printf("   %s\n", line)
+		}
+	}
+
+	if ran == 0 {
+		fmt.Println(tr.T("kata.not_found", args[0]))
+		os.Exit(exitError)
+	}
+	if failed > 0 {
+		os.Exit(exitError)
+	}
+}
+
+// 💾 Результаты упражнений из stats.json (без прогона тестов)
+func (tr *tracker) savedKataResults(passed []string) []KataResult {
+	done := map[string]bool{}
+	for _, name := range passed {
+		done[name] = true
+	}
+
+	var results []KataResult
+	for _, kata := range tr.discoverKatas(exercisesDir) {
+		results = append(results, KataResult{Kata: kata, Passed: done[kata.Name]})
+	}
+	return results
+}
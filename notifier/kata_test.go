@@ -0,0 +1,52 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// 🥋 Упражнения берутся из трекера, а не из exercises/: удалённый или
+// поправленный каталог не меняет список и темы
+func TestDiscoverKatas(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", nil)
+	katas := tr.discoverKatas(t.TempDir())
+
+	var names []string
+	for _, kata := range katas {
+		names = append(names, kata.Name)
+		if kata.Topic == "" || kata.Title == "" {
+			t.Errorf("%s: %+v", kata.Name, kata)
+		}
+	}
+	if want := []string{"pipeline", "serverrace", "workerpool"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("katas = %v, want %v", names, want)
+	}
+
+	// Каталога с решением нет — упражнение не пройдено
+	if result := runKata(katas[0]); result.Passed {
+		t.Errorf("missing exercise passed: %+v", result)
+	}
+}
+
+// 🗂️ Свои тесты ученика убираются из сборки, check_test.go — из трекера
+func TestKataOverlay(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"pipeline.go", "check_test.go", "cheat_test.go", "pipeline_test.go"} {
+		os.WriteFile(filepath.Join(dir, name), []byte("package pipeline\n"), 0644)
+	}
+
+	replace, err := kataOverlay(dir, "/tmp/kata/check_test.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		filepath.Join(dir, "check_test.go"):    "/tmp/kata/check_test.go",
+		filepath.Join(dir, "cheat_test.go"):    "",
+		filepath.Join(dir, "pipeline_test.go"): "",
+	}
+	if !reflect.DeepEqual(replace, want) {
+		t.Errorf("overlay = %v, want %v", replace, want)
+	}
+}
@@ -0,0 +1,75 @@
+//go:build kata
+
+package pipeline
+
+import (
+	"testing"
+	"time"
+)
+
+// Читает канал до закрытия, но не дольше тайм-аута
+func collect(t *testing.T, ch <-chan int) []int {
+	t.Helper()
+	if ch == nil {
+		t.Fatal("стадия вернула nil-канал")
+	}
+
+	var got []int
+	timeout := time.After(2 * time.Second)
+	for {
+		select {
+		case v, ok := <-ch:
+			if !ok {
+				return got
+			}
+			got = append(got, v)
+		case <-timeout:
+			t.Fatalf("канал не закрылся за 2с (получено: %v)", got)
+		}
+	}
+}
+
+func equal(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestGenerateNumbers(t *testing.T) {
+	got := collect(t, GenerateNumbers(5))
+	if want := []int{1, 2, 3, 4, 5}; !equal(got, want) {
+		t.Errorf("GenerateNumbers(5) = %v, want %v", got, want)
+	}
+}
+
+func TestGenerateZero(t *testing.T) {
+	if got := collect(t, GenerateNumbers(0)); len(got) != 0 {
+		t.Errorf("GenerateNumbers(0) = %v, want []", got)
+	}
+}
+
+func TestPipeline(t *testing.T) {
+	got := collect(t, MultiplyByTwo(SquareNumbers(GenerateNumbers(5))))
+	if want := []int{2, 8, 18, 32, 50}; !equal(got, want) {
+		t.Errorf("pipeline(5) = %v, want %v", got, want)
+	}
+}
+
+func TestStagesAreIndependent(t *testing.T) {
+	in := make(chan int, 3)
+	in <- 3
+	in <- -4
+	in <- 0
+	close(in)
+
+	got := collect(t, SquareNumbers(in))
+	if want := []int{9, 16, 0}; !equal(got, want) {
+		t.Errorf("SquareNumbers = %v, want %v", got, want)
+	}
+}
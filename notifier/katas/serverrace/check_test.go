@@ -0,0 +1,56 @@
+//go:build kata
+
+package serverrace
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func server(name string, delay time.Duration) func() string {
+	return func() string {
+		time.Sleep(delay)
+		return name
+	}
+}
+
+func TestFastestWins(t *testing.T) {
+	got, err := Race(time.Second,
+		server("slow", 300*time.Millisecond),
+		server("fast", 10*time.Millisecond),
+		server("medium", 100*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("Race вернул ошибку: %v", err)
+	}
+	if got != "fast" {
+		t.Errorf("Race = %q, want %q", got, "fast")
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	start := time.Now()
+	_, err := Race(50*time.Millisecond, server("slow", time.Second))
+	if !errors.Is(err, ErrTimeout) {
+		t.Fatalf("err = %v, want ErrTimeout", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Race ждал %v вместо тайм-аута 50мс", elapsed)
+	}
+}
+
+func TestSlowServersDoNotLeak(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	for i := 0; i < 20; i++ {
+		Race(time.Second, server("fast", 0), server("slow", 30*time.Millisecond))
+	}
+
+	// Даём медленным серверам завершиться
+	time.Sleep(200 * time.Millisecond)
+	if after := runtime.NumGoroutine(); after > before+2 {
+		t.Errorf("горутин до: %d, после: %d — медленные серверы зависли", before, after)
+	}
+}
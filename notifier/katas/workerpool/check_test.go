@@ -0,0 +1,92 @@
+//go:build kata
+
+package workerpool
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func makeOrders(n int) []Order {
+	orders := make([]Order, n)
+	for i := range orders {
+		orders[i] = Order{ID: i + 1, PizzaName: "Маргарита"}
+	}
+	return orders
+}
+
+func run(t *testing.T, orders []Order, chefs int, cook func(int, Order) Pizza) []Pizza {
+	t.Helper()
+	done := make(chan []Pizza, 1)
+	go func() { done <- RunKitchen(orders, chefs, cook) }()
+
+	select {
+	case pizzas := <-done:
+		return pizzas
+	case <-time.After(5 * time.Second):
+		t.Fatal("RunKitchen не завершился за 5с (забыл close или wg.Wait?)")
+	}
+	return nil
+}
+
+func TestAllOrdersCookedOnce(t *testing.T) {
+	cook := func(chefID int, o Order) Pizza {
+		return Pizza{OrderID: o.ID, PizzaName: o.PizzaName, ChefID: chefID}
+	}
+
+	pizzas := run(t, makeOrders(100), 10, cook)
+	if len(pizzas) != 100 {
+		t.Fatalf("приготовлено %d пицц, want 100", len(pizzas))
+	}
+
+	seen := map[int]bool{}
+	for _, p := range pizzas {
+		if seen[p.OrderID] {
+			t.Errorf("заказ #%d приготовлен дважды", p.OrderID)
+		}
+		seen[p.OrderID] = true
+		if p.ChefID < 1 || p.ChefID > 10 {
+			t.Errorf("заказ #%d: ChefID = %d, want 1..10", p.OrderID, p.ChefID)
+		}
+	}
+}
+
+func TestChefsWorkInParallel(t *testing.T) {
+	var active, peak int32
+	var mu sync.Mutex
+
+	cook := func(chefID int, o Order) Pizza {
+		now := atomic.AddInt32(&active, 1)
+		mu.Lock()
+		if now > peak {
+			peak = now
+		}
+		mu.Unlock()
+		time.Sleep(50 * time.Millisecond)
+		atomic.AddInt32(&active, -1)
+		return Pizza{OrderID: o.ID, ChefID: chefID}
+	}
+
+	start := time.Now()
+	run(t, makeOrders(20), 4, cook)
+
+	if peak > 4 {
+		t.Errorf("одновременно работало %d поваров, want <= 4", peak)
+	}
+	if peak < 2 {
+		t.Errorf("повара работали последовательно (пик %d)", peak)
+	}
+	// 20 заказов × 50мс / 4 повара ≈ 250мс; последовательно было бы 1с
+	if elapsed := time.Since(start); elapsed > 800*time.Millisecond {
+		t.Errorf("кухня работала %v — похоже, без параллелизма", elapsed)
+	}
+}
+
+func TestNoOrders(t *testing.T) {
+	cook := func(chefID int, o Order) Pizza { return Pizza{} }
+	if pizzas := run(t, nil, 3, cook); len(pizzas) != 0 {
+		t.Errorf("без заказов получено %d пицц", len(pizzas))
+	}
+}
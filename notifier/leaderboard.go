@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/yourusername/go-learning-tracker/tracker/leaderboard"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 🌍 Отправка на центральный leaderboard (LEADERBOARD_WEBHOOK)
+// Возвращает позицию после обновления (Position 0 — нет данных)
+// Ошибка — запись не принята (errNotConfigured — webhook не задан)
+func (tr *tracker) sendToLeaderboard(stats store.UserStats) (leaderboard.Position, error) {
+	webhookURL := os.Getenv("LEADERBOARD_WEBHOOK")
+	if webhookURL == "" {
+		fmt.Println(tr.T("leaderboard.not_configured"))
+		return leaderboard.Position{}, errNotConfigured
+	}
+
+	fmt.Println(tr.T("leaderboard.sending"))
+
+	status, body, err := leaderboard.Send(webhookURL, leaderboard.NewEntry(stats, tr.clock.Now()))
+	if err != nil {
+		fmt.Println(tr.T("leaderboard.send_error", err))
+		return leaderboard.Position{}, err
+	}
+
+	if status != 200 {
+		fmt.Println(tr.T("leaderboard.bad_status", status, body))
+		err = fmt.Errorf("status %d: %s", status, body)
+	} else {
+		fmt.Println(tr.T("leaderboard.sent"))
+		fmt.Println(tr.T("leaderboard.response", body))
+	}
+
+	// Получаем текущую позицию из leaderboard
+	return leaderboardPosition(stats.Username, webhookURL), err
+}
+
+// 📊 Получение позиции из leaderboard
+func leaderboardPosition(username, webhookURL string) leaderboard.Position {
+	rows, err := leaderboard.Fetch(webhookURL)
+	if err != nil {
+		return leaderboard.Position{}
+	}
+	return leaderboard.PositionOf(rows, username)
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/yourusername/go-learning-tracker/tracker/achievements"
+	"github.com/yourusername/go-learning-tracker/tracker/leaderboard"
+	"github.com/yourusername/go-learning-tracker/tracker/progress"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 📄 Сгенерированные файлы
+const (
+	leaderboardFile  = "LEADERBOARD.md"
+	achievementsFile = "ACHIEVEMENTS.md"
+)
+
+// 🎖️ Лиги от старшей к младшей (для распределения)
+var leagueOrder = []string{"💎 Diamond", "🥇 Gold", "🥈 Silver", "🥉 Bronze"}
+
+// 💻 Команда render: генерация LEADERBOARD.md и ACHIEVEMENTS.md из живых данных
+func (tr *tracker) runRender(args []string) {
+	target := "all"
+	if len(args) > 0 {
+		target = args[0]
+	}
+
+	switch target {
+	case "leaderboard":
+		tr.renderLeaderboardFile()
+	case "achievements":
+		tr.renderAchievementsFile()
+	case "all":
+		tr.renderAchievementsFile()
+		tr.renderLeaderboardFile()
+	default:
+		fmt.Println(tr.T("render.unknown", target))
+		os.Exit(exitUsage)
+	}
+}
+
+// 🏆 LEADERBOARD.md из данных webhook
+func (tr *tracker) renderLeaderboardFile() {
+	webhookURL := os.Getenv("LEADERBOARD_WEBHOOK")
+	if webhookURL == "" {
+		fmt.Println(tr.T("render.no_webhook", leaderboardFile))
+		return
+	}
+
+	rows, err := leaderboard.Fetch(webhookURL)
+	if err != nil {
+		fmt.Println(tr.T("render.fetch_error", err))
+		return
+	}
+
+	content := tr.renderLeaderboardMarkdown(rows, tr.clock.Now())
+	if err := tr.fs.WriteFile(leaderboardFile, []byte(content), 0644); err != nil {
+		fmt.Println(tr.T("render.write_error", leaderboardFile, err))
+		slog.Error("file not written", "path", leaderboardFile, "err", err)
+		return
+	}
+	fmt.Println(tr.T("render.leaderboard_done", leaderboardFile, len(rows)))
+}
+
+// 🏅 ACHIEVEMENTS.md из списка достижений и stats.json
+func (tr *tracker) renderAchievementsFile() {
+	content := tr.renderAchievementsMarkdown(tr.loadStats())
+	if err := tr.fs.WriteFile(achievementsFile, []byte(content), 0644); err != nil {
+		fmt.Println(tr.T("render.write_error", achievementsFile, err))
+		slog.Error("file not written", "path", achievementsFile, "err", err)
+		return
+	}
+	fmt.Println(tr.T("render.done", achievementsFile))
+}
+
+// 🥇 Медаль за место
+func positionMedal(position int) string {
+	switch position {
+	case 1:
+		return "🥇"
+	case 2:
+		return "🥈"
+	case 3:
+		return "🥉"
+	}
+	return fmt.Sprint(position)
+}
+
+// 📝 Текст LEADERBOARD.md
+func (tr *tracker) renderLeaderboardMarkdown(rows []leaderboard.Row, now time.Time) string {
+	var md strings.Builder
+
+	md.WriteString("# 🏆 Go Learning Leaderboard\n\n")
+	md.WriteString(tr.T("lbmd.subtitle") + "\n\n")
+	md.WriteString(tr.T("lbmd.generated", now.Format("2006-01-02 15:04")) + "\n\n")
+	md.WriteString("---\n\n")
+
+	md.WriteString(tr.T("lbmd.table") + "\n\n")
+	md.WriteString(tr.T("lbmd.header") + "\n")
+	md.WriteString("|---|-----|-------|--------|-----|------|--------|---------|\n")
+	if len(rows) == 0 {
+		md.WriteString(tr.T("lbmd.empty") + "\n")
+	}
+	for i, row := range rows {
+		md.WriteString(fmt.Sprintf("| %s | %s | %d | %s | %d | %d | %d | %d |\n",
+			positionMedal(i+1), row.Username, row.Level, row.League, row.XP, row.CompletedTopics, row.CurrentStreak, row.TotalCommits))
+	}
+	md.WriteString("\n---\n\n")
+
+	md.WriteString(tr.T("lbmd.stats") + "\n\n")
+	md.WriteString(tr.T("lbmd.total", len(rows)) + "\n\n")
+
+	md.WriteString(tr.T("lbmd.leagues") + "\n")
+	leagues := map[string]int{}
+	for _, row := range rows {
+		leagues[progress.LeagueTitle(row.League)]++
+	}
+	for _, league := range leagueOrder {
+		md.WriteString(tr.T("lbmd.league_line", league, leagues[progress.LeagueTitle(league)]) + "\n")
+	}
+	md.WriteString("\n")
+
+	md.WriteString(tr.T("lbmd.records") + "\n")
+	if len(rows) == 0 {
+		md.WriteString(tr.T("lbmd.record_level", "-") + "\n")
+		md.WriteString(tr.T("lbmd.record_xp", 0) + "\n")
+		md.WriteString(tr.T("lbmd.record_streak", 0) + "\n")
+		md.WriteString(tr.T("lbmd.record_commits", 0) + "\n")
+	} else {
+		level, xp, streak, commits := rows[0], rows[0], rows[0], rows[0]
+		for _, row := range rows {
+			if row.Level > level.Level {
+				level = row
+			}
+			if row.XP > xp.XP {
+				xp = row
+			}
+			if bestStreak(row) > bestStreak(streak) {
+				streak = row
+			}
+			if row.TotalCommits > commits.TotalCommits {
+				commits = row
+			}
+		}
+		md.WriteString(fmt.Sprintf("%s (%s)\n", tr.T("lbmd.record_level", level.Level), level.Username))
+		md.WriteString(fmt.Sprintf("%s (%s)\n", tr.T("lbmd.record_xp", xp.XP), xp.Username))
+		md.WriteString(fmt.Sprintf("%s (%s)\n", tr.T("lbmd.record_streak", bestStreak(streak)), streak.Username))
+		md.WriteString(fmt.Sprintf("%s (%s)\n", tr.T("lbmd.record_commits", commits.TotalCommits), commits.Username))
+	}
+	md.WriteString("\n---\n\n")
+
+	md.WriteString(tr.T("lbmd.howto") + "\n")
+
+	return md.String()
+}
+
+// 🔥 Лучший streak участника (сервер может не присылать longest_streak)
+func bestStreak(row leaderboard.Row) int {
+	if row.LongestStreak > row.CurrentStreak {
+		return row.LongestStreak
+	}
+	return row.CurrentStreak
+}
+
+// 📝 Текст ACHIEVEMENTS.md
+func (tr *tracker) renderAchievementsMarkdown(stats store.UserStats) string {
+	unlocked := map[string]bool{}
+	for _, ach := range stats.Achievements {
+		unlocked[ach.ID] = true
+	}
+
+	totalXP, earnedXP, earned := 0, 0, 0
+	for _, ach := range achievements.All() {
+		totalXP += ach.XPReward
+		if unlocked[ach.ID] {
+			earned++
+			earnedXP += ach.XPReward
+		}
+	}
+
+	localized := tr.localizedAchievements()
+
+	var md strings.Builder
+	md.WriteString(tr.T("achmd.title") + "\n\n")
+	md.WriteString(tr.T("achmd.subtitle", len(achievements.All())) + "\n\n")
+	md.WriteString(tr.T("achmd.generated") + "\n\n")
+	md.WriteString(tr.T("achmd.summary", stats.Username, earned, len(achievements.All()), earnedXP, totalXP) + "\n\n")
+	md.WriteString("---\n\n")
+
+	md.WriteString(tr.T("achmd.table") + "\n\n")
+	md.WriteString(tr.T("achmd.header") + "\n")
+	md.WriteString("|---|---|---|---|---|\n")
+	for _, ach := range localized {
+		status := "🔒"
+		if unlocked[ach.ID] {
+			status = "✅"
+		}
+		md.WriteString(fmt.Sprintf("| %s | %s | %s | +%d | %s |\n", ach.Icon, ach.Name, ach.Description, ach.XPReward, status))
+	}
+	md.WriteString("\n---\n\n")
+
+	md.WriteString(tr.T("achmd.all") + "\n")
+
+	var categories []string
+	seen := map[string]bool{}
+	for _, ach := range localized {
+		if !seen[ach.Category] {
+			seen[ach.Category] = true
+			categories = append(categories, ach.Category)
+		}
+	}
+
+	for _, category := range categories {
+		md.WriteString(fmt.Sprintf("\n### %s\n", tr.T("category."+category)))
+		for _, ach := range localized {
+			if ach.Category != category {
+				continue
+			}
+			status := tr.T("achmd.locked")
+			if unlocked[ach.ID] {
+				status = tr.T("achmd.unlocked")
+			}
+			md.WriteString(fmt.Sprintf("\n#### %s %s\n", ach.Icon, ach.Name))
+			md.WriteString("```\n")
+			md.WriteString(tr.T("achmd.reward", ach.XPReward) + "\n")
+			md.WriteString(tr.T("achmd.condition", ach.Description) + "\n")
+			md.WriteString(tr.T("achmd.status", status) + "\n")
+			md.WriteString("```\n")
+			if ach.Tip != "" {
+				md.WriteString("\n" + tr.T("achmd.how", ach.Tip) + "\n")
+			}
+		}
+	}
+
+	md.WriteString("\n---\n\n" + tr.T("achmd.footer") + "\n")
+
+	return md.String()
+}
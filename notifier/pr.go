@@ -0,0 +1,289 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yourusername/go-learning-tracker/tracker/analyzer"
+	"github.com/yourusername/go-learning-tracker/tracker/notify"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 🔮 PR: что даст merge pull request
+// Анализируются только изменённые учебные файлы (разница base...head),
+// их вклад накладывается на код base. В комментарии — темы, которые
+// merge закроет, XP за них и сколько примеров осталось до следующих.
+// Kata и review здесь не проверяются — это делает запуск после merge.
+
+// 🏷️ Скрытая метка комментария трекера: по ней он обновляется, а не дублируется
+const prCommentMarker = "<!-- go-learning-tracker -->"
+
+// 📋 Данные шаблона pr
+type PRData struct {
+	Username    string
+	Base        string // Короткие хэши сравниваемых коммитов
+	Head        string
+	Files       int       // Изменённых учебных файлов
+	Completed   []PRTopic // Темы, которые закроет merge
+	Progress    []PRTopic // Темы с новыми примерами, ещё не изученные
+	XP          int
+	LevelBefore int
+	LevelAfter  int
+	LevelName   string
+	TotalXP     int // XP после merge
+}
+
+// 📚 Тема в комментарии к PR
+type PRTopic struct {
+	ID       string
+	Name     string
+	Level    int
+	Found    int // Примеров после merge
+	Need     int
+	Added    int // Прибавка от PR
+	Missing  int // Сколько ещё не хватает
+	XPReward int
+}
+
+// 💬 Куда отправляется комментарий (в тестах — фейковый сервер)
+var prCommenter = func() notify.PRCommenter {
+	return notify.GitHub{
+		APIURL: os.Getenv("GITHUB_API_URL"),
+		Token:  os.Getenv("GITHUB_TOKEN"),
+		Repo:   os.Getenv("GITHUB_REPOSITORY"),
+		Marker: prCommentMarker,
+	}
+}
+
+// 💻 Команда pr
+func (tr *tracker) runPR(args []string) {
+	flags := flag.NewFlagSet("pr", flag.ExitOnError)
+	base := flags.String("base", defaultPRBase(), tr.T("pr.base_flag"))
+	head := flags.String("head", "HEAD", tr.T("pr.head_flag"))
+	number := flags.Int("number", prNumberFromEnv(), tr.T("pr.number_flag"))
+	post := flags.Bool("post", false, tr.T("pr.post_flag"))
+	flags.Parse(args)
+
+	if tr.teamModeEnabled() {
+		fmt.Println(tr.T("pr.team_learner"))
+		os.Exit(exitUsage)
+	}
+
+	data, err := tr.analyzePR(*base, *head)
+	if err != nil {
+		fmt.Println(tr.T("pr.git_error", err))
+		os.Exit(exitError)
+	}
+	if data.Files == 0 {
+		fmt.Println(tr.T("pr.no_changes"))
+		return
+	}
+
+	comment := tr.renderReport("pr", data)
+	fmt.Println(notify.Apply(comment, notify.Console))
+	tr.writeJobSummary(comment)
+
+	if !*post {
+		return
+	}
+	if *number == 0 {
+		fmt.Println(tr.T("pr.number_missing"))
+		os.Exit(exitUsage)
+	}
+	if err := prCommenter().CommentPR(*number, notify.Apply(comment, notify.Markdown)); err != nil {
+		fmt.Println(tr.T("pr.post_error", *number, err))
+		os.Exit(exitError)
+	}
+	fmt.Println(tr.T("pr.posted", *number))
+}
+
+// 🌿 База по умолчанию: целевая ветка PR в Actions, иначе main
+func defaultPRBase() string {
+	if ref := os.Getenv("GITHUB_BASE_REF"); ref != "" {
+		return "origin/" + ref
+	}
+	return "main"
+}
+
+// 🔢 Номер PR из GITHUB_REF (refs/pull/<номер>/merge)
+func prNumberFromEnv() int {
+	ref := strings.TrimPrefix(os.Getenv("GITHUB_REF"), "refs/pull/")
+	number, _, _ := strings.Cut(ref, "/")
+	n, _ := strconv.Atoi(number)
+	return n
+}
+
+// 🔬 Анализ PR: код merge-base плюс изменения head
+func (tr *tracker) analyzePR(base, head string) (PRData, error) {
+	output, err := exec.Command("git", "merge-base", base, head).Output()
+	if err != nil {
+		return PRData{}, gitError(err)
+	}
+	base = strings.TrimSpace(string(output))
+	output, err = exec.Command("git", "rev-parse", head).Output()
+	if err != nil {
+		return PRData{}, gitError(err)
+	}
+	head = strings.TrimSpace(string(output))
+
+	baseFiles, err := tr.learningGoBlobs(base)
+	if err != nil {
+		return PRData{}, err
+	}
+	headFiles, err := tr.learningGoBlobs(head)
+	if err != nil {
+		return PRData{}, err
+	}
+	changed := changedBlobs(baseFiles, headFiles)
+
+	data := PRData{Base: base[:7], Head: head[:7], Files: len(changed)}
+	if len(changed) == 0 {
+		return data, nil
+	}
+
+	objects, err := openGitObjects()
+	if err != nil {
+		return PRData{}, err
+	}
+	defer objects.Close()
+
+	// Весь код base — точка отсчёта
+	baseFS, err := blobFS(objects, baseFiles, nil)
+	if err != nil {
+		return PRData{}, err
+	}
+	analyzer.Analyze(tr.syllabus, baseFS, sortedPaths(baseFiles))
+	before := foundByTopic(tr.syllabus)
+
+	// Изменённые файлы до и после PR
+	oldFS, err := blobFS(objects, baseFiles, changed)
+	if err != nil {
+		return PRData{}, err
+	}
+	newFS, err := blobFS(objects, headFiles, changed)
+	if err != nil {
+		return PRData{}, err
+	}
+	scratch := make([]analyzer.Topic, len(tr.syllabus))
+	copy(scratch, tr.syllabus)
+	analyzer.Analyze(scratch, oldFS, sortedPaths(oldFS))
+	removed := foundByTopic(scratch)
+	analyzer.Analyze(scratch, newFS, sortedPaths(newFS))
+	added := foundByTopic(scratch)
+
+	for i := range tr.syllabus {
+		tr.syllabus[i].Found += added[tr.syllabus[i].ID] - removed[tr.syllabus[i].ID]
+	}
+
+	stats := tr.loadStats()
+	data.Username = stats.Username
+	if data.Username == "" {
+		data.Username = tr.getUsername()
+	}
+	data.LevelBefore = stats.Level
+	prevCompleted := tr.loadPreviousState()
+
+	// Темы, которые ждут ментора, XP не приносят и после слияния
+	tr.holdTopics(stats)
+
+	for _, topic := range tr.syllabus {
+		item := PRTopic{
+			ID:       topic.ID,
+			Name:     tr.topicName(topic),
+			Level:    topic.Level,
+			Found:    topic.Found,
+			Need:     topic.MinExamples,
+			Added:    topic.Found - before[topic.ID],
+			Missing:  topic.MinExamples - topic.Found,
+			XPReward: topic.XPReward,
+		}
+		switch {
+		case topic.Completed() && !containsTopic(prevCompleted, topic.ID):
+			data.Completed = append(data.Completed, item)
+			data.XP += topic.XPReward
+		case !topic.Completed() && item.Added > 0:
+			data.Progress = append(data.Progress, item)
+		}
+	}
+
+	stats.TotalXP += data.XP
+	data.TotalXP = stats.TotalXP
+	data.LevelAfter = tr.computeLevel(stats)
+	data.LevelName = tr.getLevelName(data.LevelAfter)
+	return data, nil
+}
+
+// 📂 Учебные .go файлы коммита (без reviews.json)
+func (tr *tracker) learningGoBlobs(commit string) (map[string]string, error) {
+	files, err := tr.learningBlobs(commit)
+	for path := range files {
+		if !tr.isLearningFile(path) {
+			delete(files, path)
+		}
+	}
+	return files, err
+}
+
+// 🔀 Пути, добавленные, изменённые или удалённые между base и head
+func changedBlobs(base, head map[string]string) map[string]bool {
+	changed := map[string]bool{}
+	for path, hash := range head {
+		if base[path] != hash {
+			changed[path] = true
+		}
+	}
+	for path := range base {
+		if _, ok := head[path]; !ok {
+			changed[path] = true
+		}
+	}
+	return changed
+}
+
+// 🗂️ Файлы из объектов git в памяти (only == nil — все)
+func blobFS(objects *gitObjects, files map[string]string, only map[string]bool) (store.MemFS, error) {
+	fsys := store.MemFS{}
+	for path, hash := range files {
+		if only != nil && !only[path] {
+			continue
+		}
+		data, err := objects.Blob(hash)
+		if err != nil {
+			return nil, err
+		}
+		fsys.WriteFile(path, data, 0644)
+	}
+	return fsys, nil
+}
+
+// 🔤 Пути в постоянном порядке
+func sortedPaths[V any](files map[string]V) []string {
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func foundByTopic(topics []analyzer.Topic) map[string]int {
+	found := map[string]int{}
+	for _, topic := range topics {
+		found[topic.ID] = topic.Found
+	}
+	return found
+}
+
+func containsTopic(ids []string, id string) bool {
+	for _, other := range ids {
+		if other == id {
+			return true
+		}
+	}
+	return false
+}
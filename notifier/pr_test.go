@@ -0,0 +1,39 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/yourusername/go-learning-tracker/tracker/notify"
+)
+
+func TestPRCommentGolden(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", nil)
+
+	data := PRData{
+		Username: "alice", Base: "1f0368a", Head: "9056bd0", Files: 2,
+		Completed: []PRTopic{{ID: "channels", Name: tr.topicDisplayName("channels"), Level: 6, Found: 9, Need: 8, Added: 9, XPReward: 200}},
+		Progress:  []PRTopic{{ID: "interfaces", Name: tr.topicDisplayName("interfaces"), Level: 5, Found: 3, Need: 5, Added: 2, Missing: 2, XPReward: 150}},
+		XP:        200, TotalXP: 1450, LevelBefore: 5, LevelAfter: 6, LevelName: tr.getLevelName(6),
+	}
+	checkGolden(t, "pr", notify.Apply(tr.renderReport("pr", data), notify.Markdown))
+}
+
+func TestPRNumberFromEnv(t *testing.T) {
+	for ref, want := range map[string]int{"refs/pull/42/merge": 42, "refs/heads/main": 0, "": 0} {
+		t.Setenv("GITHUB_REF", ref)
+		if got := prNumberFromEnv(); got != want {
+			t.Errorf("GITHUB_REF=%q: %d, want %d", ref, got, want)
+		}
+	}
+}
+
+func TestChangedBlobs(t *testing.T) {
+	base := map[string]string{"basics/a.go": "1", "basics/b.go": "2", "basics/old.go": "3"}
+	head := map[string]string{"basics/a.go": "1", "basics/b.go": "5", "basics/new.go": "4"}
+
+	got := changedBlobs(base, head)
+	want := []string{"basics/b.go", "basics/new.go", "basics/old.go"}
+	if paths := sortedPaths(got); len(paths) != len(want) || paths[0] != want[0] || paths[1] != want[1] || paths[2] != want[2] {
+		t.Errorf("changed = %v, want %v", paths, want)
+	}
+}
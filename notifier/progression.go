@@ -0,0 +1,25 @@
+package main
+
+import (
+	"github.com/yourusername/go-learning-tracker/tracker/progress"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 📈 Модель прогрессии из настроек
+// level_model (LEVEL_MODEL): strict (по умолчанию), xp или highest
+// level_curve (LEVEL_CURVE): exp:<XP за Level 2>:<множитель> или table:0,500,1250,...
+// Кривая уже проверена при загрузке настроек (config.Validate)
+func (tr *tracker) progression() progress.Progression {
+	thresholds, _ := progress.ParseCurve(tr.config.LevelCurve, tr.maxLevel())
+	return progress.Progression{Model: tr.config.LevelModel, Thresholds: thresholds}
+}
+
+// 🏆 Уровень по выбранной модели (по текущему анализу syllabus)
+func (tr *tracker) computeLevel(stats store.UserStats) int {
+	return tr.progression().Level(stats, tr.syllabus)
+}
+
+// ⏭️ Путь до следующего уровня
+func (tr *tracker) levelProgress(stats store.UserStats) progress.LevelProgress {
+	return tr.progression().Next(stats, tr.syllabus)
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 📄 README, в котором трекер обновляет свои секции
+const readmeFile = "README.md"
+
+// 📈 Сколько последних дней показывать в секции activity
+const recentActivityDays = 7
+
+// 🧱 Управляемая секция README: всё между маркерами
+//
+//	<!-- tracker:<name>:start -->
+//	...
+//	<!-- tracker:<name>:end -->
+//
+// Текст вне маркеров трекер никогда не трогает.
+type readmeSection struct {
+	Name string
+	Body string
+}
+
+func sectionStart(name string) string { return fmt.Sprintf("<!-- tracker:%s:start -->", name) }
+func sectionEnd(name string) string   { return fmt.Sprintf("<!-- tracker:%s:end -->", name) }
+
+// 📝 Обновление всех управляемых секций README
+// Итог badges и README попадает в итог запуска (recordDelivery)
+func (tr *tracker) updateReadme(stats store.UserStats, percent float64) {
+	var badges []string
+	badgesErr := errNotConfigured // shields: файлов нет
+	if tr.badgeMode() != "shields" {
+		badges, badgesErr = tr.writeLocalBadges(badgesDir, stats, percent)
+	} else {
+		badges = tr.shieldsBadges(stats, percent)
+	}
+	tr.recordDelivery("badges", badgesErr)
+
+	sections := []readmeSection{
+		{Name: "badges", Body: strings.Join(badges, "\n")},
+		{Name: "topics", Body: tr.renderTopicsChecklist()},
+		{Name: "achievements", Body: tr.renderAchievementsTable(stats)},
+		{Name: "activity", Body: tr.renderRecentActivity(stats.History)},
+		{Name: "heatmap", Body: tr.renderHeatmapSection(stats)},
+	}
+
+	tr.recordDelivery("readme", tr.updateReadmeSections(sections))
+}
+
+// ✏️ Замена секций в README (секции без маркеров пропускаются)
+// Нет README или маркеров — errNotConfigured
+func (tr *tracker) updateReadmeSections(sections []readmeSection) error {
+	data, err := fs.ReadFile(tr.fs, readmeFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return errNotConfigured
+	}
+	if err != nil {
+		return err
+	}
+
+	content := tr.migrateLegacyBadges(string(data))
+
+	updated := 0
+	for _, section := range sections {
+		var ok bool
+		content, ok = tr.replaceSection(content, section)
+		if ok {
+			updated++
+		}
+	}
+
+	if updated == 0 {
+		fmt.Println(tr.T("readme.no_markers"))
+		return errNotConfigured
+	}
+
+	if err := tr.fs.WriteFile(readmeFile, []byte(content), 0644); err != nil {
+		fmt.Println(tr.T("file.write_error", readmeFile, err))
+		slog.Error("file not written", "path", readmeFile, "err", err)
+		return err
+	}
+	fmt.Println(tr.T("readme.updated", updated))
+	return nil
+}
+
+// 🔁 Замена содержимого между маркерами секции
+// Возвращает false, если маркеров нет или они повреждены
+func (tr *tracker) replaceSection(content string, section readmeSection) (string, bool) {
+	start := sectionStart(section.Name)
+	end := sectionEnd(section.Name)
+
+	startIdx := strings.Index(content, start)
+	if startIdx < 0 {
+		return content, false
+	}
+
+	bodyIdx := startIdx + len(start)
+	endIdx := strings.Index(content[bodyIdx:], end)
+	if endIdx < 0 {
+		fmt.Println(tr.T("readme.no_end_marker", end))
+		return content, false
+	}
+	endIdx += bodyIdx
+
+	if strings.Count(content, start) > 1 {
+		fmt.Println(tr.T("readme.duplicate", section.Name))
+	}
+
+	return content[:bodyIdx] + "\n" + section.Body + "\n" + content[endIdx:], true
+}
+
+// 🚚 Старый формат: пять badges подряд без маркеров — оборачиваем их маркерами один раз
+func (tr *tracker) migrateLegacyBadges(content string) string {
+	if strings.Contains(content, sectionStart("badges")) {
+		return content
+	}
+
+	re := regexp.MustCompile(`!\[Level\].*\n!\[Progress\].*\n!\[Streak\].*\n!\[XP\].*\n!\[League\].*(\n!\[Topics\].*)?`)
+	loc := re.FindStringIndex(content)
+	if loc == nil {
+		return content
+	}
+
+	fmt.Println(tr.T("readme.migrated"))
+	return content[:loc[0]] + sectionStart("badges") + "\n" + content[loc[0]:loc[1]] + "\n" + sectionEnd("badges") + content[loc[1]:]
+}
+
+// ✅ Чек-лист тем по уровням
+func (tr *tracker) renderTopicsChecklist() string {
+	var text strings.Builder
+
+	for level := 1; level <= tr.maxLevel(); level++ {
+		if level > 1 {
+			text.WriteString("\n")
+		}
+		text.WriteString(tr.T("md.level_heading", level, tr.getLevelName(level)) + "\n\n")
+		for _, topic := range tr.syllabus {
+			if topic.Level != level {
+				continue
+			}
+			if topic.Completed() {
+				text.WriteString(fmt.Sprintf("- [x] %s\n", tr.topicName(topic)))
+			} else {
+				text.WriteString(fmt.Sprintf("- [ ] %s (%d/%d)\n", tr.topicName(topic), topic.Found, topic.MinExamples))
+			}
+		}
+	}
+
+	return strings.TrimRight(text.String(), "\n")
+}
+
+// 🏆 Таблица достижений с отметкой о разблокировке
+func (tr *tracker) renderAchievementsTable(stats store.UserStats) string {
+	unlocked := map[string]bool{}
+	for _, ach := range stats.Achievements {
+		unlocked[ach.ID] = true
+	}
+
+	var text strings.Builder
+	text.WriteString(tr.T("md.achievements_header") + "\n")
+	text.WriteString("|---|---|---|---|---|\n")
+	for _, ach := range tr.localizedAchievements() {
+		status := "🔒"
+		if unlocked[ach.ID] {
+			status = "✅"
+		}
+		text.WriteString(fmt.Sprintf("| %s | %s | %s | +%d | %s |\n", ach.Icon, ach.Name, ach.Description, ach.XPReward, status))
+	}
+
+	return strings.TrimRight(text.String(), "\n")
+}
+
+// 📅 Последние дни активности из истории
+func (tr *tracker) renderRecentActivity(history []store.HistoryPoint) string {
+	if len(history) == 0 {
+		return tr.T("md.no_activity")
+	}
+
+	var lines []string
+	for i := len(history) - 1; i >= 0 && len(lines) < recentActivityDays; i-- {
+		point := history[i]
+		line := tr.T("md.activity_line", point.Date, point.TotalXP, point.Level, point.CompletedTopics)
+		if i > 0 {
+			if delta := point.TotalXP - history[i-1].TotalXP; delta != 0 {
+				line += fmt.Sprintf(" (%+d)", delta)
+			}
+		}
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
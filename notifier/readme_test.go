@@ -0,0 +1,85 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+func TestReplaceSection(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", nil)
+	section := readmeSection{Name: "topics", Body: "- [x] Типы"}
+	start, end := sectionStart("topics"), sectionEnd("topics")
+
+	tests := []struct {
+		name    string
+		content string
+		want    string
+		ok      bool
+	}{
+		{"замена", "# Go\n" + start + "\nстарое\n" + end + "\nконец\n",
+			"# Go\n" + start + "\n- [x] Типы\n" + end + "\nконец\n", true},
+		{"пустая секция", start + end, start + "\n- [x] Типы\n" + end, true},
+		{"нет маркеров", "# Go\n", "# Go\n", false},
+		{"чужая секция", sectionStart("badges") + "\n" + sectionEnd("badges"), sectionStart("badges") + "\n" + sectionEnd("badges"), false},
+		{"нет конца", "# Go\n" + start + "\nстарое\n", "# Go\n" + start + "\nстарое\n", false},
+		{"конец раньше начала", end + "\n" + start + "\n", end + "\n" + start + "\n", false},
+		// Повтор секции: обновляется первая, вторая остаётся как есть
+		{"дубликат", start + "\nа\n" + end + "\n" + start + "\nб\n" + end,
+			start + "\n- [x] Типы\n" + end + "\n" + start + "\nб\n" + end, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tr.replaceSection(tt.content, section)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("replaceSection = %q, %v\nwant %q, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+// 🚚 Старые badges без маркеров оборачиваются маркерами один раз
+func TestMigrateLegacyBadges(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", nil)
+	legacy := "# Go\n![Level](a)\n![Progress](b)\n![Streak](c)\n![XP](d)\n![League](e)\n\nтекст\n"
+	want := "# Go\n" + sectionStart("badges") + "\n![Level](a)\n![Progress](b)\n![Streak](c)\n![XP](d)\n![League](e)\n" +
+		sectionEnd("badges") + "\n\nтекст\n"
+
+	got := tr.migrateLegacyBadges(legacy)
+	if got != want {
+		t.Fatalf("migrateLegacyBadges:\n%s\nwant:\n%s", got, want)
+	}
+	if again := tr.migrateLegacyBadges(got); again != got {
+		t.Errorf("migrated twice:\n%s", again)
+	}
+	if partial := "![Level](a)\n![XP](d)\n"; tr.migrateLegacyBadges(partial) != partial {
+		t.Errorf("partial badge list migrated")
+	}
+}
+
+func TestUpdateReadmeSections(t *testing.T) {
+	sections := []readmeSection{{Name: "topics", Body: "новое"}}
+
+	tr := newTestTracker(t, "2026-03-01", nil)
+	memfs := tr.fs.(store.MemFS)
+	if err := tr.updateReadmeSections(sections); !errors.Is(err, errNotConfigured) {
+		t.Errorf("no README: err = %v", err)
+	}
+
+	memfs.WriteFile(readmeFile, []byte("# Go\n"), 0644)
+	if err := tr.updateReadmeSections(sections); !errors.Is(err, errNotConfigured) {
+		t.Errorf("no markers: err = %v", err)
+	}
+
+	readme := "# Мой путь\n" + sectionStart("topics") + "\n" + sectionEnd("topics") + "\nЗаметки\n"
+	memfs.WriteFile(readmeFile, []byte(readme), 0644)
+	if err := tr.updateReadmeSections(sections); err != nil {
+		t.Fatal(err)
+	}
+	want := "# Мой путь\n" + sectionStart("topics") + "\nновое\n" + sectionEnd("topics") + "\nЗаметки\n"
+	if got := string(memfs[readmeFile].Data); got != want {
+		t.Errorf("README:\n%s\nwant:\n%s", got, want)
+	}
+}
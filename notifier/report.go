@@ -0,0 +1,234 @@
+package main
+
+import (
+	"embed"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/yourusername/go-learning-tracker/tracker/achievements"
+	"github.com/yourusername/go-learning-tracker/tracker/config"
+	"github.com/yourusername/go-learning-tracker/tracker/integrity"
+	"github.com/yourusername/go-learning-tracker/tracker/leaderboard"
+	"github.com/yourusername/go-learning-tracker/tracker/notify"
+	"github.com/yourusername/go-learning-tracker/tracker/progress"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 📨 Встроенные шаблоны отчёта: templates/report/<имя>.tmpl
+//
+//go:embed templates/report/*.tmpl
+var reportTemplates embed.FS
+
+// 📮 Шаблон по умолчанию для каждого получателя отчёта
+// Переопределяется через REPORT_TEMPLATE_<ПОЛУЧАТЕЛЬ>: имя встроенного
+// шаблона (default, compact, detailed) или путь к своему .tmpl
+var defaultReportTemplates = map[string]string{
+	"console":  "default",
+	"telegram": "default",
+	"markdown": "detailed",
+	"bot":      "compact",
+	"digest":   "digest",
+	"team":     "team",
+	"summary":  "summary",
+	"pr":       "pr",
+}
+
+// 📊 Данные, доступные в шаблонах отчёта
+type ReportData struct {
+	Stats           store.UserStats
+	LevelName       string
+	Percent         float64
+	Completed       int
+	Total           int
+	Levels          []ReportLevel // Все уровни syllabus с темами
+	FocusTopics     []ReportTopic // Темы текущего и следующего уровня
+	NextTopic       string
+	Hint            string // Готовый текст подсказки (formatHint)
+	NewAchievements []achievements.Achievement
+	XP              progress.XPBreakdown
+	Leaderboard     leaderboard.Position
+	NextLevel       progress.LevelProgress // Сколько осталось до следующего уровня
+	SeasonEnd       *store.SeasonResult    // Итог сезона, завершённого этим запуском
+	PendingReview   []string               // Темы, ждущие одобрения ментора (review mode)
+	Rejections      []Review               // Отказы ментора, которых ещё не было в отчёте
+	IntegrityFlags  []integrity.Flag       // Подозрения в накрутке, найденные этим запуском
+	Config          config.Config          // Настройки (порог streak, число тем в фокусе)
+	Files           int                    // Проанализировано файлов
+	NewTopicIDs     []string               // ID тем, изученных в этом запуске
+	Date            string
+}
+
+// 📚 Уровень в отчёте
+type ReportLevel struct {
+	Level  int
+	Name   string
+	Topics []ReportTopic
+}
+
+// 📖 Тема в отчёте
+type ReportTopic struct {
+	ID        string
+	Name      string
+	Level     int
+	Found     int
+	Need      int
+	XPReward  int
+	Completed bool
+}
+
+// 🧩 Функции шаблонов отчёта (t — перевод на язык трекера)
+func (tr *tracker) reportFuncs() template.FuncMap {
+	return template.FuncMap{
+		"t":      tr.T,
+		"bar":    progressBar,
+		"medal":  reportMedal,
+		"bold":   notify.Bold,
+		"italic": notify.Italic,
+		"first": func(n int, topics []ReportTopic) []ReportTopic {
+			if len(topics) > n {
+				return topics[:n]
+			}
+			return topics
+		},
+	}
+}
+
+// 🧾 Общая часть данных отчёта: статистика и темы из syllabus
+func (tr *tracker) newReportData(stats store.UserStats, completed, total int) ReportData {
+	data := ReportData{
+		Stats:     stats,
+		LevelName: tr.getLevelName(stats.Level),
+		Completed: completed,
+		Total:     total,
+		NextLevel: tr.levelProgress(stats),
+		Config:    tr.config,
+		Date:      tr.clock.Now().Format("2006-01-02"),
+	}
+	if total > 0 {
+		data.Percent = float64(completed) / float64(total) * 100
+	}
+
+	for level := 1; level <= tr.maxLevel(); level++ {
+		view := ReportLevel{Level: level, Name: tr.getLevelName(level)}
+		for _, topic := range tr.syllabus {
+			if topic.Level != level {
+				continue
+			}
+			item := ReportTopic{
+				ID:        topic.ID,
+				Name:      tr.topicName(topic),
+				Level:     topic.Level,
+				Found:     topic.Found,
+				Need:      topic.MinExamples,
+				XPReward:  topic.XPReward,
+				Completed: topic.Completed(),
+			}
+			view.Topics = append(view.Topics, item)
+			if level == stats.Level || level == stats.Level+1 {
+				data.FocusTopics = append(data.FocusTopics, item)
+			}
+		}
+		data.Levels = append(data.Levels, view)
+	}
+
+	return data
+}
+
+// 📝 Отчёт для получателя (console, telegram, markdown, bot — ReportData;
+// digest — DigestData, pr — PRData). Разметка остаётся маркерами — её подставляет notify.Apply
+func (tr *tracker) renderReport(notifier string, data interface{}) string {
+	builtin := defaultReportTemplates[notifier]
+	if builtin == "" {
+		builtin = "default"
+	}
+
+	tmpl, err := tr.loadReportTemplate(notifier)
+	if err != nil {
+		fmt.Println(tr.T("report.template_error", notifier, err))
+		slog.Error("report template failed", "notifier", notifier, "err", err)
+		tmpl, _ = tr.parseBuiltinReportTemplate(builtin)
+	}
+
+	var text strings.Builder
+	if err := tmpl.Execute(&text, data); err != nil {
+		fmt.Println(tr.T("report.template_error", notifier, err))
+		slog.Error("report template failed", "notifier", notifier, "err", err)
+		text.Reset()
+		fallback, _ := tr.parseBuiltinReportTemplate(builtin)
+		fallback.Execute(&text, data)
+	}
+	return text.String()
+}
+
+// 📂 Шаблон получателя: из REPORT_TEMPLATE_<ПОЛУЧАТЕЛЬ> или по умолчанию
+func (tr *tracker) loadReportTemplate(notifier string) (*template.Template, error) {
+	name := os.Getenv("REPORT_TEMPLATE_" + strings.ToUpper(notifier))
+	if name == "" {
+		name = defaultReportTemplates[notifier]
+	}
+	if name == "" {
+		name = "default"
+	}
+
+	if strings.HasSuffix(name, ".tmpl") {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		return template.New(filepath.Base(name)).Funcs(tr.reportFuncs()).Parse(string(data))
+	}
+	return tr.parseBuiltinReportTemplate(name)
+}
+
+// 📦 Встроенный шаблон по имени
+func (tr *tracker) parseBuiltinReportTemplate(name string) (*template.Template, error) {
+	return template.New(name+".tmpl").Funcs(tr.reportFuncs()).ParseFS(reportTemplates, "templates/report/"+name+".tmpl")
+}
+
+// ▰ Полоса прогресса из width клеток
+func progressBar(percent float64, width int) string {
+	filled := int((percent / 100) * float64(width))
+	var bar strings.Builder
+	for i := 0; i < width; i++ {
+		if i < filled {
+			bar.WriteString("▰")
+		} else {
+			bar.WriteString("▱")
+		}
+	}
+	return bar.String()
+}
+
+// 🥇 Медаль за место в leaderboard
+func reportMedal(position int) string {
+	switch position {
+	case 1:
+		return "🥇"
+	case 2:
+		return "🥈"
+	case 3:
+		return "🥉"
+	}
+	return "🏅"
+}
+
+// 📄 Markdown-отчёт в файл из REPORT_FILE (для писем, wiki, артефактов CI)
+func (tr *tracker) writeReportFile(data ReportData) error {
+	path := os.Getenv("REPORT_FILE")
+	if path == "" {
+		return errNotConfigured
+	}
+
+	content := notify.Apply(tr.renderReport("markdown", data), notify.Markdown)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		fmt.Println(tr.T("file.write_error", path, err))
+		slog.Error("file not written", "path", path, "err", err)
+		return err
+	}
+	fmt.Println(tr.T("render.done", path))
+	return nil
+}
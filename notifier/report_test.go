@@ -0,0 +1,107 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/yourusername/go-learning-tracker/tracker/notify"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 🔄 go test ./notifier -update — перезаписать testdata/*.golden
+var update = flag.Bool("update", false, "update golden files")
+
+// 📏 Сравнение с testdata/<name>.golden
+func checkGolden(t *testing.T, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", name+".golden")
+
+	if *update {
+		if err := os.WriteFile(path, []byte(got), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v (run go test -update)", err)
+	}
+	if got != string(want) {
+		t.Errorf("%s differs from %s:\n--- got ---\n%s\n--- want ---\n%s", name, path, got, want)
+	}
+}
+
+// 📅 Четыре запуска: три дня подряд через границу месяца и день после пропуска
+func trackedReport(t *testing.T) (*tracker, ReportData) {
+	tr := newTestTracker(t, "2026-01-30", map[string]string{"basics/main.go": basicsSource})
+	memfs := tr.fs.(store.MemFS)
+	trackOn(t, tr, "2026-01-30")
+	memfs.WriteFile("basics/conditions.go", []byte(conditionsSource), 0644)
+	trackOn(t, tr, "2026-01-31")
+	trackOn(t, tr, "2026-02-01")
+	return tr, trackOn(t, tr, "2026-02-05")
+}
+
+func TestReportGolden(t *testing.T) {
+	tr, report := trackedReport(t)
+
+	tests := []struct {
+		name     string
+		notifier string
+		markup   notify.Markup
+	}{
+		{"console", "console", notify.Console},
+		{"telegram_html", "telegram", notify.HTML},
+		{"bot", "bot", notify.MarkdownV2},
+		{"markdown", "markdown", notify.Markdown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkGolden(t, tt.name, notify.Apply(tr.renderReport(tt.notifier, report), tt.markup))
+		})
+	}
+}
+
+func TestReportSeasonEndGolden(t *testing.T) {
+	tr := newTestTracker(t, "2026-01-31", map[string]string{"basics/main.go": basicsSource})
+	trackOn(t, tr, "2026-01-31")
+	report := trackOn(t, tr, "2026-02-01")
+
+	checkGolden(t, "season_end", notify.Apply(tr.renderReport("bot", report), notify.Console))
+}
+
+func TestDigestGolden(t *testing.T) {
+	tr, report := trackedReport(t)
+
+	digest := tr.buildDigest(report.Stats, "week", digestPeriods["week"], tr.clock.Now())
+	checkGolden(t, "digest", notify.Apply(tr.renderReport("digest", digest), notify.Console))
+}
+
+func TestTeamGolden(t *testing.T) {
+	tr := newTestTracker(t, "2026-01-31", map[string]string{
+		"learners/alice/main.go":       basicsSource,
+		"learners/alice/conditions.go": conditionsSource,
+		"learners/bob/main.go":         conditionsSource,
+	})
+
+	learners := tr.discoverLearners()
+	if len(learners) != 2 {
+		t.Fatalf("learners = %+v", learners)
+	}
+
+	// bob не коммитил — его строка строится из сохранённой статистики
+	reports := map[string]ReportData{}
+	for _, learner := range learners {
+		report, _ := tr.learner(learner.Dir).trackProgress()
+		if learner.Name == "alice" {
+			reports[learner.Name] = report
+		}
+	}
+
+	data := tr.buildTeam(learners, reports)
+	checkGolden(t, "team", notify.Apply(tr.renderReport("team", data), notify.Console))
+	checkGolden(t, "team_readme", tr.renderTeamTable(data))
+}
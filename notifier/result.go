@@ -0,0 +1,202 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/yourusername/go-learning-tracker/tracker/config"
+)
+
+// 🧾 ИТОГ ЗАПУСКА: код выхода, журнал и JSON для CI
+// Консольный отчёт — для человека; журнал slog (stderr, text или json)
+// и result_file (TRACKER_RESULT_FILE) — для машины. По коду выхода CI
+// отличает «нечего анализировать», «не всё доставлено» и «битое состояние».
+
+// 🚦 Коды выхода
+const (
+	exitOK              = 0
+	exitError           = 1 // Команда не выполнена (git, сеть, файлы)
+	exitUsage           = 2 // Неверные аргументы или настройки
+	exitNoGoFiles       = 3 // Нет учебных .go файлов — статистика не изменена
+	exitPartialDelivery = 4 // Прогресс сохранён, но часть отправок не удалась
+	exitCorruptState    = 5 // stats.json или .completed_topics не читаются — ничего не записано
+)
+
+var exitStatus = map[int]string{
+	exitOK:              "ok",
+	exitError:           "error",
+	exitNoGoFiles:       "no_go_files",
+	exitPartialDelivery: "partial_delivery",
+	exitCorruptState:    "corrupt_state",
+}
+
+// 📋 Итог запуска (result_file)
+type RunResult struct {
+	Status        string          `json:"status"`
+	ExitCode      int             `json:"exit_code"`
+	Date          string          `json:"date"`
+	Error         string          `json:"error,omitempty"`
+	Learners      []LearnerResult `json:"learners"`
+	Notifications []Delivery      `json:"notifications"`
+}
+
+// 🧑‍🎓 Что было проанализировано и начислено ученику
+type LearnerResult struct {
+	Username        string   `json:"username"`
+	FilesAnalyzed   int      `json:"files_analyzed"`
+	CompletedTopics int      `json:"completed_topics"`
+	TotalTopics     int      `json:"total_topics"`
+	NewTopics       []string `json:"new_topics"`
+	NewAchievements []string `json:"new_achievements"`
+	XP              XPResult `json:"xp"`
+	TotalXP         int      `json:"total_xp"`
+	Level           int      `json:"level"`
+	League          string   `json:"league"`
+}
+
+// 💰 XP запуска по источникам
+type XPResult struct {
+	Topics       int `json:"topics"`
+	Streak       int `json:"streak"`
+	Achievements int `json:"achievements"`
+	Season       int `json:"season"`
+	Penalty      int `json:"penalty"`
+	Net          int `json:"net"`
+}
+
+// 📬 Отправка: badges, readme, leaderboard, telegram, report_file
+type Delivery struct {
+	Channel string `json:"channel"`
+	Status  string `json:"status"` // sent, failed или skipped
+	Error   string `json:"error,omitempty"`
+}
+
+// ⏭️ Получатель не настроен — отправка пропущена, это не ошибка
+var errNotConfigured = errors.New("not configured")
+
+func newRunResult() RunResult {
+	return RunResult{Learners: []LearnerResult{}, Notifications: []Delivery{}}
+}
+
+// 🔧 Журнал slog по настройкам: уровень и формат, вывод — stderr
+func setupLogging(cfg config.Config) {
+	slog.SetDefault(newLogger(os.Stderr, cfg))
+}
+
+func newLogger(w io.Writer, cfg config.Config) *slog.Logger {
+	var level slog.Level
+	level.UnmarshalText([]byte(cfg.LogLevel))
+	options := &slog.HandlerOptions{Level: level}
+	if cfg.LogFormat == "json" {
+		return slog.New(slog.NewJSONHandler(w, options))
+	}
+	return slog.New(slog.NewTextHandler(w, options))
+}
+
+// 🧑‍🎓 Ученик в итоге запуска
+func (tr *tracker) recordLearner(report ReportData) {
+	result := LearnerResult{
+		Username:        report.Stats.Username,
+		FilesAnalyzed:   report.Files,
+		CompletedTopics: report.Completed,
+		TotalTopics:     report.Total,
+		NewTopics:       []string{},
+		NewAchievements: []string{},
+		XP: XPResult{
+			Topics:       report.XP.Topics,
+			Streak:       report.XP.Streak,
+			Achievements: report.XP.Achievements,
+			Season:       report.XP.Season,
+			Penalty:      report.XP.Penalty,
+			Net:          report.XP.Net(),
+		},
+		TotalXP: report.Stats.TotalXP,
+		Level:   report.Stats.Level,
+		League:  report.Stats.League,
+	}
+	result.NewTopics = append(result.NewTopics, report.NewTopicIDs...)
+	for _, ach := range report.NewAchievements {
+		result.NewAchievements = append(result.NewAchievements, ach.ID)
+	}
+	tr.result.Learners = append(tr.result.Learners, result)
+}
+
+// 📬 Результат отправки (err == errNotConfigured — пропущена)
+func (tr *tracker) recordDelivery(channel string, err error) {
+	delivery := Delivery{Channel: channel, Status: "sent"}
+	switch {
+	case errors.Is(err, errNotConfigured):
+		delivery.Status = "skipped"
+		slog.Debug("delivery skipped", "channel", channel)
+	case err != nil:
+		delivery.Status = "failed"
+		delivery.Error = err.Error()
+		slog.Warn("delivery failed", "channel", channel, "err", err)
+	default:
+		slog.Info("delivered", "channel", channel)
+	}
+	tr.result.Notifications = append(tr.result.Notifications, delivery)
+}
+
+// 🚦 Код выхода: ошибка запуска, иначе — была ли неудачная отправка
+func exitCode(err error, result RunResult) int {
+	switch {
+	case errors.Is(err, errCorruptState):
+		return exitCorruptState
+	case errors.Is(err, errNoGoFiles):
+		return exitNoGoFiles
+	case err != nil:
+		return exitError
+	}
+	for _, delivery := range result.Notifications {
+		if delivery.Status == "failed" {
+			return exitPartialDelivery
+		}
+	}
+	return exitOK
+}
+
+// 🏁 Конец запуска трекера: итог в журнал, result_file и outputs шага,
+// ненулевой код — выход из процесса
+func (tr *tracker) finishRun(err error) {
+	code := exitCode(err, *tr.result)
+	tr.result.ExitCode = code
+	tr.result.Status = exitStatus[code]
+	tr.result.Date = tr.clock.Now().Format("2006-01-02")
+	if err != nil {
+		tr.result.Error = err.Error()
+	}
+
+	tr.writeRunResult(*tr.result)
+	tr.setRunOutputs(*tr.result)
+
+	level := slog.LevelInfo
+	if code != exitOK {
+		level = slog.LevelError
+	}
+	slog.Log(context.Background(), level, "run finished", "status", tr.result.Status, "exit_code", code,
+		"learners", len(tr.result.Learners), "notifications", len(tr.result.Notifications))
+
+	if code != exitOK {
+		os.Exit(code)
+	}
+}
+
+// 📄 Итог запуска в result_file
+func (tr *tracker) writeRunResult(result RunResult) {
+	path := tr.config.ResultFile
+	if path == "" {
+		return
+	}
+
+	data, _ := json.MarshalIndent(result, "", "  ")
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		fmt.Println(tr.T("file.write_error", path, err))
+		slog.Error("result file not written", "path", path, "err", err)
+	}
+}
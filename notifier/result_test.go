@@ -0,0 +1,158 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/yourusername/go-learning-tracker/tracker/config"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+func TestExitCode(t *testing.T) {
+	failed := RunResult{Notifications: []Delivery{{Channel: "readme", Status: "skipped"}, {Channel: "telegram", Status: "failed"}}}
+	delivered := RunResult{Notifications: []Delivery{{Channel: "readme", Status: "sent"}, {Channel: "telegram", Status: "skipped"}}}
+
+	tests := []struct {
+		name   string
+		err    error
+		result RunResult
+		want   int
+	}{
+		{"ok", nil, delivered, exitOK},
+		{"partial delivery", nil, failed, exitPartialDelivery},
+		{"no go files", errNoGoFiles, RunResult{}, exitNoGoFiles},
+		{"corrupt state", fmt.Errorf("%w: stats.json", errCorruptState), failed, exitCorruptState},
+		{"team", errors.Join(errors.New("bob: git"), errors.New("carol: disk")), delivered, exitError},
+	}
+	for _, tt := range tests {
+		if got := exitCode(tt.err, tt.result); got != tt.want {
+			t.Errorf("%s: exitCode = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRecordDelivery(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", nil)
+
+	tr.recordDelivery("readme", nil)
+	tr.recordDelivery("leaderboard", errNotConfigured)
+	tr.recordDelivery("telegram", errors.New("429 Too Many Requests"))
+
+	want := []Delivery{
+		{Channel: "readme", Status: "sent"},
+		{Channel: "leaderboard", Status: "skipped"},
+		{Channel: "telegram", Status: "failed", Error: "429 Too Many Requests"},
+	}
+	if fmt.Sprint(tr.result.Notifications) != fmt.Sprint(want) {
+		t.Errorf("Notifications = %+v, want %+v", tr.result.Notifications, want)
+	}
+}
+
+// 📄 result_file: ученик с новыми темами и XP по источникам
+func TestWriteRunResult(t *testing.T) {
+	tr, report := trackedReport(t)
+	path := filepath.Join(t.TempDir(), "result.json")
+	tr.config.ResultFile = path
+
+	tr.result.Status, tr.result.ExitCode = "ok", exitOK
+	tr.recordLearner(report)
+	tr.writeRunResult(*tr.result)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got RunResult
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	learner := got.Learners[0]
+	if got.Status != "ok" || learner.Username != "alice" || learner.TotalXP != report.Stats.TotalXP {
+		t.Fatalf("result = %s", data)
+	}
+	if learner.XP.Penalty != 90 || learner.XP.Net != report.XP.Net() || learner.FilesAnalyzed != 2 {
+		t.Errorf("learner = %+v", learner)
+	}
+}
+
+// 🧨 Битый stats.json: запуск прерывается и файл не перезаписывается
+func TestTrackProgressCorruptState(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", map[string]string{
+		"basics/main.go": basicsSource,
+		store.StatsFile:  `{"total_xp": 12`,
+	})
+	memfs := tr.fs.(store.MemFS)
+
+	_, err := tr.trackProgress()
+	if !errors.Is(err, errCorruptState) {
+		t.Fatalf("err = %v, want errCorruptState", err)
+	}
+	if data := string(memfs[store.StatsFile].Data); data != `{"total_xp": 12` {
+		t.Errorf("%s overwritten: %s", store.StatsFile, data)
+	}
+}
+
+func TestTrackProgressNoGoFiles(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", map[string]string{"basics/notes.md": "# notes"})
+	memfs := tr.fs.(store.MemFS)
+
+	if _, err := tr.trackProgress(); !errors.Is(err, errNoGoFiles) {
+		t.Fatalf("err = %v, want errNoGoFiles", err)
+	}
+	if _, ok := memfs[store.StatsFile]; ok {
+		t.Errorf("%s written without Go files", store.StatsFile)
+	}
+}
+
+func TestNewLogger(t *testing.T) {
+	var buf bytes.Buffer
+	cfg := config.Default()
+	cfg.LogLevel, cfg.LogFormat = "info", "json"
+
+	logger := newLogger(&buf, cfg)
+	logger.Debug("hidden")
+	logger.Info("code analyzed", "files", 2)
+
+	if out := buf.String(); strings.Contains(out, "hidden") || !strings.Contains(out, `"msg":"code analyzed","files":2`) {
+		t.Errorf("log = %s", out)
+	}
+}
+
+// 🪵 Ошибки, которые раньше были только в консоли, попадают в журнал
+func TestErrorPathsLogged(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", map[string]string{
+		"hints/broken.json": `[{"topic": `,
+		"reviews.json":      `[{"learner": "alice", "topic": "types", "status": "approved", "signature": "forged"}]`,
+	})
+	memfs := tr.fs.(store.MemFS)
+	t.Setenv("REVIEW_SECRET", "s3cret")
+
+	var buf bytes.Buffer
+	cfg := config.Default()
+	cfg.LogFormat = "json"
+	prev := slog.Default()
+	t.Cleanup(func() { slog.SetDefault(prev) })
+	slog.SetDefault(newLogger(&buf, cfg))
+
+	tr.loadHintBank("hints")
+	if reviews := tr.readReviews(memfs, "reviews.json", "alice"); len(reviews) != 0 {
+		t.Errorf("forged review accepted: %+v", reviews)
+	}
+
+	out := buf.String()
+	for _, want := range []string{
+		`"level":"WARN","msg":"corrupt hint bank","file":"hints/broken.json"`,
+		`"level":"WARN","msg":"review signature mismatch","learner":"alice","topic":"types"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log has no %s:\n%s", want, out)
+		}
+	}
+}
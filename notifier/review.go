@@ -0,0 +1,276 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/yourusername/go-learning-tracker/tracker/analyzer"
+	"github.com/yourusername/go-learning-tracker/tracker/engine"
+	"github.com/yourusername/go-learning-tracker/tracker/integrity"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 🧑‍🏫 Review mode: новая тема засчитывается только после одобрения ментора
+// Решения лежат в reviews.json рядом со stats.json и подписаны
+// HMAC-SHA256 с секретом REVIEW_SECRET — вручную их не подделать
+const reviewsFile = "reviews.json"
+
+const (
+	reviewApproved = "approved"
+	reviewRejected = "rejected"
+)
+
+// 📝 Решение ментора по теме
+type Review struct {
+	Learner   string `json:"learner"`
+	Topic     string `json:"topic"` // ID темы
+	Status    string `json:"status"`
+	Mentor    string `json:"mentor"`
+	Comment   string `json:"comment,omitempty"`
+	Date      string `json:"date"`
+	Signature string `json:"signature"`
+
+	TopicName string `json:"-"` // Название темы на текущем языке (для шаблонов отчёта)
+}
+
+// 🔛 review: true (TRACKER_REVIEW=1) включает проверку ментором
+func (tr *tracker) reviewModeEnabled() bool {
+	return tr.config.Review
+}
+
+func (tr *tracker) reviewsPath() string { return tr.learnerStore().Path(reviewsFile) }
+
+// 🔏 Подпись решения: HMAC-SHA256 от всех полей, кроме самой подписи
+func signReview(review Review, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strings.Join([]string{
+		review.Learner, review.Topic, review.Status, review.Mentor, review.Comment, review.Date,
+	}, "\n")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// 📥 Подписанные решения для ученика (неподписанные и чужие пропускаются)
+func (tr *tracker) loadReviews(learner string) []Review {
+	return tr.readReviews(tr.fs, tr.reviewsPath(), learner)
+}
+
+// 📥 Подписанные решения для ученика из файла name в fsys
+func (tr *tracker) readReviews(fsys fs.FS, name, learner string) []Review {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil
+	}
+
+	var reviews []Review
+	if err := json.Unmarshal(data, &reviews); err != nil {
+		fmt.Println(tr.T("review.file_error", name, err))
+		slog.Error("corrupt reviews", "file", name, "err", err)
+		return nil
+	}
+
+	secret := os.Getenv("REVIEW_SECRET")
+	if secret == "" {
+		fmt.Println(tr.T("review.no_secret"))
+		slog.Warn("reviews ignored: REVIEW_SECRET not set", "file", name)
+		return nil
+	}
+
+	var valid []Review
+	for _, review := range reviews {
+		if !hmac.Equal([]byte(review.Signature), []byte(signReview(review, secret))) {
+			fmt.Println(tr.T("review.bad_signature", review.Topic, review.Mentor))
+			slog.Warn("review signature mismatch", "learner", review.Learner, "topic", review.Topic, "mentor", review.Mentor)
+			continue
+		}
+		if review.Learner == learner {
+			valid = append(valid, review)
+		}
+	}
+	return valid
+}
+
+// 🗂️ Последнее решение по каждой теме
+func latestReviews(reviews []Review) map[string]Review {
+	latest := map[string]Review{}
+	for _, review := range reviews {
+		latest[review.Topic] = review
+	}
+	return latest
+}
+
+// ✅ Темы, одобренные ментором
+func approvedTopics(reviews map[string]Review) map[string]bool {
+	approved := map[string]bool{}
+	for id, review := range reviews {
+		if review.Status == reviewApproved {
+			approved[id] = true
+		}
+	}
+	return approved
+}
+
+// 📥 Нужны ли решения ментора: review mode или темы, придержанные integrity
+// (сохранёнными флагами или подозрениями этого запуска)
+func (tr *tracker) needsReviews(stats store.UserStats, flags []integrity.Flag) bool {
+	return tr.reviewModeEnabled() || tr.integrityEnabled() && (len(flags) > 0 || len(stats.IntegrityFlags) > 0)
+}
+
+// 🔒 Темы на проверке у ментора по текущему анализу (без начисления XP) —
+// те же правила, что и в запуске трекера. Возвращает решения ментора
+func (tr *tracker) holdTopics(stats store.UserStats) map[string]Review {
+	var reviews map[string]Review
+	if tr.needsReviews(stats, nil) {
+		reviews = latestReviews(tr.loadReviews(stats.Username))
+	}
+	engine.Hold(tr.syllabus, tr.loadPreviousState(), stats, approvedTopics(reviews), tr.engineRules())
+	return reviews
+}
+
+// 💬 Отказы, которых ещё не было в отчёте (запоминаются в stats.SeenReviews)
+func (tr *tracker) unseenRejections(stats *store.UserStats, reviews map[string]Review) []Review {
+	seen := map[string]bool{}
+	for _, signature := range stats.SeenReviews {
+		seen[signature] = true
+	}
+
+	var rejections []Review
+	for _, topic := range tr.syllabus {
+		review, ok := reviews[topic.ID]
+		if !ok || review.Status != reviewRejected || seen[review.Signature] {
+			continue
+		}
+		review.TopicName = tr.topicName(topic)
+		rejections = append(rejections, review)
+		stats.SeenReviews = append(stats.SeenReviews, review.Signature)
+	}
+	return rejections
+}
+
+// 💡 Решение для подсказки по теме (nil — тема не на проверке)
+func topicReview(topic analyzer.Topic, reviews map[string]Review) *Review {
+	if !topic.ReviewPending {
+		return nil
+	}
+	review := reviews[topic.ID]
+	return &review
+}
+
+// 📋 Темы, ждущие ментора
+func (tr *tracker) pendingReviewTopics() []string {
+	var pending []string
+	for _, topic := range tr.syllabus {
+		if topic.ReviewPending {
+			pending = append(pending, tr.topicName(topic))
+		}
+	}
+	return pending
+}
+
+// 📋 Список тем на проверке с ID для approve/reject
+func (tr *tracker) formatPendingReviews() string {
+	var text strings.Builder
+	for _, topic := range tr.syllabus {
+		if topic.ReviewPending {
+			text.WriteString(fmt.Sprintf("  ⏳ %s (%s)\n", tr.topicName(topic), topic.ID))
+		}
+	}
+	if text.Len() == 0 {
+		return tr.T("review.none_pending") + "\n"
+	}
+	return tr.T("review.pending", strings.Count(text.String(), "\n")) + "\n" + text.String()
+}
+
+// ✍️ Новое подписанное решение в reviews.json
+func (tr *tracker) addReview(learner, topic, status, mentor, comment string) (Review, error) {
+	secret := os.Getenv("REVIEW_SECRET")
+	if secret == "" {
+		return Review{}, errors.New(tr.T("review.no_secret"))
+	}
+
+	known := false
+	for _, t := range tr.syllabus {
+		if t.ID == topic {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return Review{}, errors.New(tr.T("review.unknown_topic", topic))
+	}
+	if status == reviewRejected && comment == "" {
+		return Review{}, errors.New(tr.T("review.comment_required"))
+	}
+
+	var reviews []Review
+	if data, err := fs.ReadFile(tr.fs, tr.reviewsPath()); err == nil {
+		if err := json.Unmarshal(data, &reviews); err != nil {
+			return Review{}, errors.New(tr.T("review.file_error", tr.reviewsPath(), err))
+		}
+	}
+
+	review := Review{
+		Learner: learner,
+		Topic:   topic,
+		Status:  status,
+		Mentor:  mentor,
+		Comment: comment,
+		Date:    tr.clock.Now().Format("2006-01-02"),
+	}
+	review.Signature = signReview(review, secret)
+	reviews = append(reviews, review)
+
+	data, _ := json.MarshalIndent(reviews, "", "  ")
+	if err := tr.fs.WriteFile(tr.reviewsPath(), data, 0644); err != nil {
+		return Review{}, err
+	}
+	return review, nil
+}
+
+// 🧑‍🏫 Имя ментора для решений из консоли: REVIEW_MENTOR или GITHUB_ACTOR
+func reviewMentor() string {
+	if mentor := os.Getenv("REVIEW_MENTOR"); mentor != "" {
+		return mentor
+	}
+	if actor := os.Getenv("GITHUB_ACTOR"); actor != "" {
+		return actor
+	}
+	return "mentor"
+}
+
+// 💻 Команда review: список тем на проверке или решение ментора
+//
+//	review                          — темы, ждущие одобрения
+//	review approve <тема> [коммент] — засчитать тему
+//	review reject <тема> <коммент>  — вернуть на доработку
+func (tr *tracker) runReview(args []string) {
+	stats := tr.loadStats()
+
+	if len(args) == 0 {
+		tr.analyzeCodebase(false)
+		tr.holdTopics(stats)
+
+		fmt.Print(tr.formatPendingReviews())
+		return
+	}
+
+	status := map[string]string{"approve": reviewApproved, "reject": reviewRejected}[args[0]]
+	if status == "" || len(args) < 2 {
+		fmt.Println(tr.T("review.usage"))
+		os.Exit(exitUsage)
+	}
+
+	review, err := tr.addReview(stats.Username, args[1], status, reviewMentor(), strings.Join(args[2:], " "))
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(exitError)
+	}
+	fmt.Println(tr.T("review.saved_"+review.Status, tr.topicDisplayName(review.Topic), review.Learner))
+}
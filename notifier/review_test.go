@@ -0,0 +1,113 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 🔏 Принимаются только решения с верной подписью и для этого ученика
+func TestLoadReviewsSignature(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", nil)
+	memfs := tr.fs.(store.MemFS)
+	t.Setenv("REVIEW_SECRET", "s3cret")
+
+	for _, review := range []struct{ learner, topic string }{{"alice", "types"}, {"bob", "loops"}} {
+		if _, err := tr.addReview(review.learner, review.topic, reviewApproved, "mentor", "ok"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if reviews := tr.loadReviews("alice"); len(reviews) != 1 || reviews[0].Topic != "types" {
+		t.Fatalf("valid: %+v", reviews)
+	}
+
+	t.Setenv("REVIEW_SECRET", "guess")
+	if reviews := tr.loadReviews("alice"); len(reviews) != 0 {
+		t.Errorf("wrong REVIEW_SECRET: %+v", reviews)
+	}
+	t.Setenv("REVIEW_SECRET", "")
+	if reviews := tr.loadReviews("alice"); len(reviews) != 0 {
+		t.Errorf("no REVIEW_SECRET: %+v", reviews)
+	}
+
+	// Правка любого поля вручную ломает подпись
+	t.Setenv("REVIEW_SECRET", "s3cret")
+	for _, tamper := range []func(*Review){
+		func(r *Review) { r.Topic = "goroutines" },
+		func(r *Review) { r.Learner = "bob" },
+		func(r *Review) { r.Comment = "" },
+		func(r *Review) { r.Date = "2026-01-01" },
+	} {
+		var reviews []Review
+		json.Unmarshal(memfs["reviews.json"].Data, &reviews)
+		tamper(&reviews[0])
+		data, _ := json.Marshal(reviews[:1])
+		if got := tr.readReviews(store.MemFS{"reviews.json": {Data: data}}, "reviews.json", reviews[0].Learner); len(got) != 0 {
+			t.Errorf("tampered review accepted: %+v", got[0])
+		}
+	}
+}
+
+// ↩️ Вернуть тему на доработку можно только с комментарием
+func TestAddReviewValidation(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", nil)
+	memfs := tr.fs.(store.MemFS)
+	t.Setenv("REVIEW_SECRET", "s3cret")
+
+	if _, err := tr.addReview("alice", "types", reviewRejected, "bob", ""); err == nil || err.Error() != tr.T("review.comment_required") {
+		t.Errorf("reject without comment: err = %v", err)
+	}
+	if _, err := tr.addReview("alice", "nope", reviewApproved, "bob", ""); err == nil || err.Error() != tr.T("review.unknown_topic", "nope") {
+		t.Errorf("unknown topic: err = %v", err)
+	}
+	if _, ok := memfs["reviews.json"]; ok {
+		t.Errorf("reviews.json written for a refused decision")
+	}
+
+	t.Setenv("REVIEW_SECRET", "")
+	if _, err := tr.addReview("alice", "types", reviewApproved, "bob", ""); err == nil {
+		t.Error("decision signed without REVIEW_SECRET")
+	}
+}
+
+// 🧑‍🏫 Review mode: XP за тему — только после одобрения, отказ показывается один раз
+func TestReviewModeXP(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", map[string]string{"basics/main.go": basicsSource})
+	tr.config.Review = true
+	t.Setenv("REVIEW_SECRET", "s3cret")
+
+	day1 := trackOn(t, tr, "2026-03-01")
+	if day1.XP.Topics != 0 || len(day1.PendingReview) != 2 || day1.Completed != 0 {
+		t.Fatalf("day 1: topics XP %d, pending %v", day1.XP.Topics, day1.PendingReview)
+	}
+
+	if _, err := tr.addReview("alice", "types", reviewApproved, "bob", ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tr.addReview("alice", "variables", reviewRejected, "bob", "используй :="); err != nil {
+		t.Fatal(err)
+	}
+	day2 := trackOn(t, tr, "2026-03-02")
+	if day2.XP.Topics != 50 || strings.Join(day2.NewTopicIDs, ",") != "types" || len(day2.PendingReview) != 1 {
+		t.Errorf("day 2: topics XP %d, new %v, pending %v", day2.XP.Topics, day2.NewTopicIDs, day2.PendingReview)
+	}
+	if len(day2.Rejections) != 1 || day2.Rejections[0].Comment != "используй :=" {
+		t.Errorf("day 2 rejections: %+v", day2.Rejections)
+	}
+
+	day3 := trackOn(t, tr, "2026-03-03")
+	if day3.XP.Topics != 0 || len(day3.Rejections) != 0 {
+		t.Errorf("day 3: topics XP %d, rejections %+v", day3.XP.Topics, day3.Rejections)
+	}
+
+	// Повторная проверка после доработки
+	if _, err := tr.addReview("alice", "variables", reviewApproved, "bob", ""); err != nil {
+		t.Fatal(err)
+	}
+	day4 := trackOn(t, tr, "2026-03-04")
+	if day4.XP.Topics != 50 || len(day4.PendingReview) != 0 || day4.Completed != 2 {
+		t.Errorf("day 4: topics XP %d, pending %v, completed %d", day4.XP.Topics, day4.PendingReview, day4.Completed)
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"os"
+
+	"github.com/yourusername/go-learning-tracker/tracker/leaderboard"
+	"github.com/yourusername/go-learning-tracker/tracker/progress"
+)
+
+// 📆 Длина сезона: month (по умолчанию) или quarter (season, TRACKER_SEASON)
+func (tr *tracker) seasonLength() string {
+	return tr.config.Season
+}
+
+// 📊 Участники сезона: в team mode — ученики команды (локально),
+// иначе — общий leaderboard (если настроен)
+func (tr *tracker) seasonStandings(season string) []progress.Standing {
+	if tr.dir != "." {
+		var rows []progress.Standing
+		for _, learner := range tr.discoverLearners() {
+			if row, ok := progress.SeasonStanding(tr.learner(learner.Dir).loadStats(), season); ok {
+				rows = append(rows, row)
+			}
+		}
+		return rows
+	}
+
+	webhookURL := os.Getenv("LEADERBOARD_WEBHOOK")
+	if webhookURL == "" {
+		return nil
+	}
+	all, err := leaderboard.Fetch(webhookURL)
+	if err != nil {
+		return nil
+	}
+
+	// Кто уже перешёл в новый сезон, отдаёт итог закрытого в prev_season*
+	var rows []progress.Standing
+	for _, row := range all {
+		switch season {
+		case row.Season:
+			rows = append(rows, progress.Standing{Username: row.Username, League: row.League, SeasonXP: row.SeasonXP})
+		case row.PrevSeason:
+			rows = append(rows, progress.Standing{Username: row.Username, League: row.PrevLeague, SeasonXP: row.PrevSeasonXP})
+		}
+	}
+	return rows
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/yourusername/go-learning-tracker/tracker/leaderboard"
+	"github.com/yourusername/go-learning-tracker/tracker/progress"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 📊 Закрытый сезон ранжируется и по тем, кто уже перешёл в новый
+func TestSeasonStandingsLeaderboard(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", nil)
+
+	bob := store.NewStats("bob")
+	bob.Season, bob.SeasonXP, bob.League = "2026-03", 40, "Silver"
+	bob.Seasons = []store.SeasonResult{{ID: "2026-02", XP: 900, League: "Bronze", NewLeague: "Silver"}}
+	rows := []leaderboard.Row{
+		rowFromEntry(leaderboard.NewEntry(bob, tr.clock.Now())),
+		{Username: "carol", League: "Bronze", Season: "2026-02", SeasonXP: 300},
+		{Username: "dave", League: "Bronze", Season: "2026-01", SeasonXP: 700},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"status": "ok", "leaderboard": rows})
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv("LEADERBOARD_WEBHOOK", server.URL)
+
+	want := []progress.Standing{
+		{Username: "bob", League: "Bronze", SeasonXP: 900},
+		{Username: "carol", League: "Bronze", SeasonXP: 300},
+	}
+	if got := tr.seasonStandings("2026-02"); !reflect.DeepEqual(got, want) {
+		t.Errorf("seasonStandings = %+v, want %+v", got, want)
+	}
+}
+
+// 🔁 Строка таблицы такой, какой её вернёт webhook после POST
+func rowFromEntry(entry leaderboard.Entry) leaderboard.Row {
+	data, _ := json.Marshal(entry)
+	var row leaderboard.Row
+	json.Unmarshal(data, &row)
+	return row
+}
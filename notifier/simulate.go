@@ -0,0 +1,248 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/yourusername/go-learning-tracker/tracker/analyzer"
+	"github.com/yourusername/go-learning-tracker/tracker/engine"
+	"github.com/yourusername/go-learning-tracker/tracker/progress"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 🎞️ SIMULATE: история git заново через трекер
+// Каждый коммит, изменивший учебный код, — один запуск трекера в день
+// коммита. Рабочий каталог не трогается: файлы берутся из объектов git,
+// статистика копится в памяти с нуля. Так видно, как XP, уровень и лига
+// менялись бы по текущим (или подменённым флагами) правилам.
+
+// 📍 Шаг симуляции: состояние ученика после запуска на коммите
+type SimulationStep struct {
+	Commit        string   `json:"commit"`
+	Date          string   `json:"date"`
+	TotalXP       int      `json:"total_xp"`
+	XP            int      `json:"xp"` // Итог запуска (с достижениями, сезоном и штрафом)
+	Penalty       int      `json:"penalty"`
+	Level         int      `json:"level"`
+	League        string   `json:"league"`
+	CurrentStreak int      `json:"current_streak"`
+	Topics        int      `json:"completed_topics"`
+	NewTopics     []string `json:"new_topics"`
+	Unlocked      []string `json:"unlocked"`
+}
+
+// 💻 Команда simulate
+func (tr *tracker) runSimulate(args []string) {
+	flags := flag.NewFlagSet("simulate", flag.ExitOnError)
+	rev := flags.String("rev", "HEAD", tr.T("simulate.rev_flag"))
+	format := flags.String("format", "csv", tr.T("simulate.format_flag"))
+	out := flags.String("o", "", tr.T("simulate.out_flag"))
+	rewards := flags.String("xp", "", tr.T("simulate.xp_flag"))
+	rules := tr.simulationRules()
+	flags.IntVar(&rules.XP.PenaltyPerDay, "penalty", rules.XP.PenaltyPerDay, tr.T("simulate.penalty_flag"))
+	flags.IntVar(&rules.XP.StreakBonusPerDay, "streak-bonus", rules.XP.StreakBonusPerDay, tr.T("simulate.streak_flag"))
+	flags.Parse(args)
+
+	if *format != "csv" && *format != "json" {
+		fmt.Println(tr.T("simulate.format_error", *format))
+		os.Exit(exitUsage)
+	}
+	topics := analyzer.DefaultSyllabus()
+	if err := overrideRewards(topics, *rewards); err != nil {
+		fmt.Println(tr.T("simulate.xp_error", err))
+		os.Exit(exitUsage)
+	}
+	if tr.teamModeEnabled() {
+		fmt.Println(tr.T("simulate.team_learner"))
+		os.Exit(exitUsage)
+	}
+
+	steps, err := tr.simulateHistory(*rev, topics, rules)
+	if err != nil {
+		fmt.Println(tr.T("simulate.git_error", err))
+		slog.Error("history not simulated", "rev", *rev, "err", err)
+		os.Exit(exitError)
+	}
+
+	w := io.Writer(os.Stdout)
+	if *out != "" {
+		file, err := os.Create(*out)
+		if err != nil {
+			fmt.Println(tr.T("file.write_error", *out, err))
+			slog.Error("file not written", "path", *out, "err", err)
+			os.Exit(exitError)
+		}
+		defer file.Close()
+		w = file
+	}
+
+	if *format == "json" {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(steps)
+	} else {
+		err = writeSimulationCSV(w, steps)
+	}
+	if err != nil {
+		fmt.Println(tr.T("file.write_error", *out, err))
+		slog.Error("file not written", "path", *out, "err", err)
+		os.Exit(exitError)
+	}
+
+	if *out != "" {
+		fmt.Println(tr.T("simulate.done", len(steps), *out))
+	}
+}
+
+// ⚖️ Правила прогона: текущие настройки, но общий leaderboard знает только
+// настоящее, упражнения проверяются на диске, а integrity смотрит на HEAD —
+// в прошлом их не воспроизвести
+func (tr *tracker) simulationRules() engine.Rules {
+	rules := tr.engineRules()
+	rules.Integrity = false
+	return rules
+}
+
+// 🎯 Подмена XP за темы: "types=80,loops=100"
+func overrideRewards(topics []analyzer.Topic, spec string) error {
+	if spec == "" {
+		return nil
+	}
+	for _, pair := range strings.Split(spec, ",") {
+		id, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		xp, err := strconv.Atoi(value)
+		if !ok || err != nil {
+			return fmt.Errorf("%q", pair)
+		}
+		found := false
+		for i := range topics {
+			if topics[i].ID == id {
+				topics[i].XPReward = xp
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("unknown topic %q", id)
+		}
+	}
+	return nil
+}
+
+// 🔁 Прогон истории: запуск трекера на каждом коммите с новым учебным кодом
+// topics — программа обучения (без результатов анализа)
+func (tr *tracker) simulateHistory(rev string, topics []analyzer.Topic, rules engine.Rules) ([]SimulationStep, error) {
+	commits, err := gitHistory(rev)
+	if err != nil {
+		return nil, err
+	}
+
+	objects, err := openGitObjects()
+	if err != nil {
+		return nil, err
+	}
+	defer objects.Close()
+
+	stats := store.NewStats(tr.getUsername())
+	var previous []string
+	var code map[string]string // Путь → blob учебного кода прошлого запуска
+	var steps []SimulationStep
+
+	for _, commit := range commits {
+		files, err := tr.learningBlobs(commit.Hash)
+		if err != nil {
+			return nil, err
+		}
+		if sameBlobs(files, code) {
+			continue
+		}
+		code = files
+
+		fsys, err := blobFS(objects, files, nil)
+		if err != nil {
+			return nil, err
+		}
+		paths := analyzer.FindGoFiles(fsys, tr.dir, tr.isLearningFile)
+		if len(paths) == 0 {
+			continue
+		}
+		analyzed := make([]analyzer.Topic, len(topics))
+		copy(analyzed, topics)
+		analyzer.Analyze(analyzed, fsys, paths)
+
+		// Решения ментора — из reviews.json того же коммита
+		var approved map[string]bool
+		if rules.Review {
+			approved = approvedTopics(latestReviews(tr.readReviews(fsys, tr.reviewsPath(), stats.Username)))
+		}
+
+		run := engine.Run(engine.Input{
+			Stats:    stats,
+			Topics:   analyzed,
+			Previous: previous,
+			Now:      commit.Date.UTC(),
+			Commits:  1,
+			Approved: approved,
+		}, rules)
+		stats, previous = run.Stats, analyzer.CompletedIDs(run.Topics)
+		steps = append(steps, simulationStep(commit, run))
+	}
+
+	return steps, nil
+}
+
+// 📍 Шаг из итога запуска
+func simulationStep(commit gitCommit, run engine.Result) SimulationStep {
+	step := SimulationStep{
+		Commit:        commit.Hash[:7],
+		Date:          run.Stats.LastCommitDate,
+		TotalXP:       run.Stats.TotalXP,
+		XP:            run.XP.Net(),
+		Penalty:       run.XP.Penalty,
+		Level:         run.Stats.Level,
+		League:        progress.LeagueTitle(run.Stats.League),
+		CurrentStreak: run.Stats.CurrentStreak,
+		Topics:        run.Stats.CompletedTopics,
+		NewTopics:     append([]string{}, run.NewTopics...),
+		Unlocked:      []string{},
+	}
+	for _, ach := range run.NewAchievements {
+		step.Unlocked = append(step.Unlocked, ach.ID)
+	}
+	return step
+}
+
+// 📄 Таблица шагов (списки — через ";")
+func writeSimulationCSV(w io.Writer, steps []SimulationStep) error {
+	out := csv.NewWriter(w)
+	out.Write([]string{"commit", "date", "total_xp", "xp", "penalty", "level", "league", "current_streak", "completed_topics", "new_topics", "unlocked"})
+	for _, step := range steps {
+		out.Write([]string{
+			step.Commit, step.Date,
+			strconv.Itoa(step.TotalXP), strconv.Itoa(step.XP), strconv.Itoa(step.Penalty),
+			strconv.Itoa(step.Level), step.League, strconv.Itoa(step.CurrentStreak), strconv.Itoa(step.Topics),
+			strings.Join(step.NewTopics, ";"), strings.Join(step.Unlocked, ";"),
+		})
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// 🔁 Учебный код тот же, что в прошлом запуске
+func sameBlobs(a, b map[string]string) bool {
+	if b == nil || len(a) != len(b) {
+		return false
+	}
+	for path, hash := range a {
+		if b[path] != hash {
+			return false
+		}
+	}
+	return true
+}
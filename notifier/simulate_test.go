@@ -0,0 +1,73 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/yourusername/go-learning-tracker/tracker/analyzer"
+)
+
+func TestOverrideRewards(t *testing.T) {
+	topics := analyzer.DefaultSyllabus()
+	if err := overrideRewards(topics, "types=80, loops=100"); err != nil {
+		t.Fatal(err)
+	}
+	if types, _ := analyzer.Find(topics, "types"); types.XPReward != 80 {
+		t.Errorf("types = %d, want 80", types.XPReward)
+	}
+	if loops, _ := analyzer.Find(topics, "loops"); loops.XPReward != 100 {
+		t.Errorf("loops = %d, want 100", loops.XPReward)
+	}
+
+	for _, spec := range []string{"types", "types=x", "nope=10"} {
+		if err := overrideRewards(topics, spec); err == nil {
+			t.Errorf("overrideRewards(%q): want error", spec)
+		}
+	}
+}
+
+// ⚖️ Флаги simulate меняют копию правил, а не настройки трекера
+func TestSimulationRules(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", nil)
+	tr.config.Integrity = true
+
+	rules := tr.simulationRules()
+	rules.XP.PenaltyPerDay = 100
+	if rules.Integrity || tr.config.PenaltyPerDay != 30 || tr.config.Rules().PenaltyPerDay != 30 {
+		t.Errorf("rules %+v, config penalty %d", rules, tr.config.PenaltyPerDay)
+	}
+}
+
+func TestWriteSimulationCSV(t *testing.T) {
+	steps := []SimulationStep{
+		{Commit: "fa9274c", Date: "2026-01-30", TotalXP: 220, XP: 220, Level: 1, League: "Bronze", CurrentStreak: 1, Topics: 2,
+			NewTopics: []string{"types", "variables"}, Unlocked: []string{"first_commit"}},
+		{Commit: "39a14f1", Date: "2026-02-06", TotalXP: 295, XP: -80, Penalty: 150, Level: 2, League: "Bronze", CurrentStreak: 1, Topics: 3},
+	}
+
+	var out strings.Builder
+	if err := writeSimulationCSV(&out, steps); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `commit,date,total_xp,xp,penalty,level,league,current_streak,completed_topics,new_topics,unlocked
+fa9274c,2026-01-30,220,220,0,1,Bronze,1,2,types;variables,first_commit
+39a14f1,2026-02-06,295,-80,150,2,Bronze,1,3,,
+`
+	if out.String() != want {
+		t.Errorf("CSV:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
+
+func TestSameBlobs(t *testing.T) {
+	a := map[string]string{"basics/a.go": "1"}
+	if sameBlobs(a, nil) {
+		t.Error("first commit must always run")
+	}
+	if !sameBlobs(a, map[string]string{"basics/a.go": "1"}) {
+		t.Error("unchanged code")
+	}
+	if sameBlobs(a, map[string]string{"basics/a.go": "2"}) || sameBlobs(a, map[string]string{"basics/b.go": "1"}) {
+		t.Error("changed code")
+	}
+}
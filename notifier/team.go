@@ -0,0 +1,285 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/yourusername/go-learning-tracker/tracker/achievements"
+	"github.com/yourusername/go-learning-tracker/tracker/notify"
+	"github.com/yourusername/go-learning-tracker/tracker/progress"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 👥 Team mode: несколько учеников в одном репозитории
+//
+//	learners/<имя>/...       — код ученика (анализируется отдельно)
+//	learners/<имя>/stats.json — его статистика
+//	learners/team.json       — необязательные псевдонимы авторов коммитов
+const (
+	learnersDir    = "learners"
+	teamConfigFile = "team.json"
+	teamCommitFile = ".last_commit" // Последний обработанный коммит
+)
+
+// 🧑‍🎓 Ученик команды
+type Learner struct {
+	Name    string
+	Dir     string
+	Authors []string // Имена и email в git, кроме самого имени каталога
+}
+
+// ⚙️ learners/team.json: {"authors": {"alice": ["Alice Smith", "alice@example.com"]}}
+type teamConfig struct {
+	Authors map[string][]string `json:"authors"`
+}
+
+// 📊 Данные шаблона командного отчёта (templates/report/team.tmpl)
+type TeamData struct {
+	Date    string
+	Members []TeamMember // По убыванию XP
+	Updated int          // Сколько учеников обновлено в этом запуске
+}
+
+// 🧑‍🎓 Строка командного отчёта
+type TeamMember struct {
+	Rank            int
+	Stats           store.UserStats
+	LevelName       string
+	Percent         float64
+	Updated         bool                 // Был коммит в этом запуске
+	XP              progress.XPBreakdown // XP за этот запуск (только у Updated)
+	NewAchievements []achievements.Achievement
+}
+
+// 🔛 Team mode включается каталогом learners/
+func (tr *tracker) teamModeEnabled() bool {
+	if tr.dir != "." {
+		return false
+	}
+	info, err := fs.Stat(tr.fs, learnersDir)
+	return err == nil && info.IsDir()
+}
+
+// 🔎 Ученики: каталоги learners/<имя>
+func (tr *tracker) discoverLearners() []Learner {
+	entries, err := fs.ReadDir(tr.fs, learnersDir)
+	if err != nil {
+		return nil
+	}
+
+	var config teamConfig
+	if data, err := fs.ReadFile(tr.fs, path.Join(learnersDir, teamConfigFile)); err == nil {
+		if err := json.Unmarshal(data, &config); err != nil {
+			fmt.Println(tr.T("team.config_error", err))
+		}
+	}
+
+	var learners []Learner
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		learners = append(learners, Learner{
+			Name:    entry.Name(),
+			Dir:     path.Join(learnersDir, entry.Name()),
+			Authors: config.Authors[entry.Name()],
+		})
+	}
+	return learners
+}
+
+// ✍️ Чей коммит: автор совпадает с именем каталога, псевдонимом из
+// team.json или логином в email (123+alice@users.noreply.github.com)
+func (l Learner) IsAuthor(name, email string) bool {
+	login := strings.SplitN(email, "@", 2)[0]
+	if plus := strings.Index(login, "+"); plus >= 0 {
+		login = login[plus+1:]
+	}
+
+	for _, alias := range append([]string{l.Name}, l.Authors...) {
+		if strings.EqualFold(alias, name) || strings.EqualFold(alias, email) || strings.EqualFold(alias, login) {
+			return true
+		}
+	}
+	return false
+}
+
+// 👤 Авторы коммитов с прошлого запуска (или последнего коммита)
+// Возвращает пары "имя\temail" и HEAD; без git — пустой список
+func (tr *tracker) commitAuthors() ([]string, string) {
+	head, err := exec.Command("git", "rev-parse", "HEAD").Output()
+	if err != nil {
+		return nil, ""
+	}
+
+	args := []string{"log", "--no-merges", "--format=%an%x09%ae"}
+	last, _ := fs.ReadFile(tr.fs, path.Join(learnersDir, teamCommitFile))
+	since := strings.TrimSpace(string(last))
+	if since != "" && exec.Command("git", "cat-file", "-e", since+"^{commit}").Run() == nil {
+		args = append(args, since+"..HEAD")
+	} else {
+		args = append(args, "-1")
+	}
+
+	output, err := exec.Command("git", args...).Output()
+	if err != nil {
+		return nil, ""
+	}
+
+	var authors []string
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		if line != "" {
+			authors = append(authors, line)
+		}
+	}
+	return authors, strings.TrimSpace(string(head))
+}
+
+// 🎯 Кому засчитать запуск: по одному разу каждому автору новых коммитов
+// Нет новых коммитов (cron, ручной запуск) или git — никому
+func creditedLearners(learners []Learner, authors []string) []Learner {
+	var credited []Learner
+	for _, learner := range learners {
+		for _, author := range authors {
+			name, email, _ := strings.Cut(author, "\t")
+			if learner.IsAuthor(name, email) {
+				credited = append(credited, learner)
+				break
+			}
+		}
+	}
+	return credited
+}
+
+// 🚀 Запуск трекера в team mode
+// Ученик без кода пропускается; битое состояние одного ученика не мешает
+// остальным, но возвращается ошибкой (код выхода corrupt_state)
+func (tr *tracker) runTeam() error {
+	learners := tr.discoverLearners()
+	if len(learners) == 0 {
+		fmt.Println(tr.T("team.no_learners", learnersDir))
+		return nil
+	}
+
+	authors, head := tr.commitAuthors()
+	credited := creditedLearners(learners, authors)
+	if len(credited) == 0 {
+		fmt.Println(tr.T("team.no_credited"))
+	}
+
+	reports := map[string]ReportData{}
+	var corrupt []error
+	for _, learner := range credited {
+		fmt.Println("\n" + tr.T("team.learner", learner.Name))
+		report, err := tr.learner(learner.Dir).trackProgress()
+		switch {
+		case err == nil:
+			reports[learner.Name] = report
+			tr.recordLearner(report)
+		case !errors.Is(err, errNoGoFiles):
+			corrupt = append(corrupt, err)
+		}
+	}
+
+	if head != "" {
+		file := path.Join(learnersDir, teamCommitFile)
+		if err := tr.fs.WriteFile(file, []byte(head+"\n"), 0644); err != nil {
+			fmt.Println(tr.T("file.write_error", file, err))
+			slog.Error("file not written", "path", file, "err", err)
+		}
+	}
+
+	data := tr.buildTeam(learners, reports)
+	tr.recordDelivery("readme", tr.updateReadmeSections([]readmeSection{{Name: "team", Body: tr.renderTeamTable(data)}}))
+
+	message := tr.renderReport("team", data)
+	fmt.Println("\n" + notify.Apply(message, notify.Console))
+
+	// В GitHub Actions: командная таблица и итоги каждого обновлённого ученика
+	tr.writeJobSummary("## " + tr.T("team.title") + "\n\n" + tr.renderTeamTable(data) + "\n")
+	for _, member := range data.Members {
+		if report, ok := reports[member.Stats.Username]; ok {
+			tr.reportToActions(report)
+		}
+	}
+	if data.Updated > 0 {
+		tr.recordDelivery("telegram", tr.sendToTelegram(message))
+	}
+
+	fmt.Println("\n" + tr.T("run.done"))
+	return errors.Join(corrupt...)
+}
+
+// 💻 Команда team: командный отчёт без изменения статистики
+func (tr *tracker) runTeamCommand(args []string) {
+	learners := tr.discoverLearners()
+	if len(learners) == 0 {
+		fmt.Println(tr.T("team.no_learners", learnersDir))
+		os.Exit(exitError)
+	}
+
+	fmt.Println(notify.Apply(tr.renderReport("team", tr.buildTeam(learners, nil)), notify.Console))
+}
+
+// 🏅 Командный leaderboard: считается локально по stats.json учеников
+func (tr *tracker) buildTeam(learners []Learner, reports map[string]ReportData) TeamData {
+	data := TeamData{Date: tr.clock.Now().Format("2006-01-02")}
+
+	for _, learner := range learners {
+		member := TeamMember{}
+		if report, ok := reports[learner.Name]; ok {
+			member.Stats = report.Stats
+			member.Updated = true
+			member.XP = report.XP
+			member.NewAchievements = report.NewAchievements
+			data.Updated++
+		} else {
+			member.Stats = tr.learner(learner.Dir).loadStats()
+		}
+
+		member.LevelName = tr.getLevelName(member.Stats.Level)
+		if len(tr.syllabus) > 0 {
+			member.Percent = float64(member.Stats.CompletedTopics) / float64(len(tr.syllabus)) * 100
+		}
+		data.Members = append(data.Members, member)
+	}
+
+	sort.SliceStable(data.Members, func(i, j int) bool {
+		if data.Members[i].Stats.TotalXP != data.Members[j].Stats.TotalXP {
+			return data.Members[i].Stats.TotalXP > data.Members[j].Stats.TotalXP
+		}
+		return data.Members[i].Stats.Username < data.Members[j].Stats.Username
+	})
+	for i := range data.Members {
+		data.Members[i].Rank = i + 1
+	}
+
+	return data
+}
+
+// 📋 Секция README: таблица командного leaderboard
+func (tr *tracker) renderTeamTable(data TeamData) string {
+	var text strings.Builder
+	text.WriteString(tr.T("md.team_header") + "\n")
+	text.WriteString("|---|---|---|---|---|---|---|\n")
+	for _, member := range data.Members {
+		stats := member.Stats
+		lastCommit := stats.LastCommitDate
+		if lastCommit == "" {
+			lastCommit = "—"
+		}
+		text.WriteString(fmt.Sprintf("| %s %d | %s | %d · %s | %d | %d/%d | %d | %s |\n",
+			reportMedal(member.Rank), member.Rank, stats.Username, stats.Level, member.LevelName,
+			stats.TotalXP, stats.CompletedTopics, len(tr.syllabus), stats.CurrentStreak, lastCommit))
+	}
+
+	return strings.TrimRight(text.String(), "\n")
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+// 🎯 Запуск засчитывается только авторам новых коммитов
+func TestCreditedLearners(t *testing.T) {
+	t.Setenv("GITHUB_ACTOR", "alice")
+	learners := []Learner{
+		{Name: "alice", Dir: "learners/alice"},
+		{Name: "bob", Dir: "learners/bob", Authors: []string{"Robert B"}},
+		{Name: "carol", Dir: "learners/carol"},
+	}
+
+	tests := []struct {
+		name    string
+		authors []string
+		want    []string
+	}{
+		{"имя каталога", []string{"alice\talice@example.com"}, []string{"alice"}},
+		{"псевдоним и noreply", []string{"Robert B\tb@work.com", "C\t123+carol@users.noreply.github.com"}, []string{"bob", "carol"}},
+		{"два коммита одного автора", []string{"bob\tx", "Robert B\ty"}, []string{"bob"}},
+		{"чужой автор", []string{"maintainer\tm@example.com"}, nil},
+		// cron или ручной запуск: GITHUB_ACTOR не ученик-автор
+		{"нет новых коммитов", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, learner := range creditedLearners(learners, tt.authors) {
+				got = append(got, learner.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("creditedLearners = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
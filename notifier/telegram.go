@@ -0,0 +1,62 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/yourusername/go-learning-tracker/tracker/notify"
+)
+
+// 🤖 Клиент Bot API из окружения
+// TELEGRAM_API_URL — для локального сервера или тестов,
+// TELEGRAM_PARSE_MODE=MarkdownV2 (по умолчанию) или HTML
+func (tr *tracker) telegramClient(token string) notify.Telegram {
+	return notify.Telegram{
+		APIURL: os.Getenv("TELEGRAM_API_URL"),
+		Token:  token,
+		HTML:   strings.EqualFold(tr.config.TelegramParseMode, "HTML"),
+		OnPlainFallback: func(description string) {
+			fmt.Println(tr.T("telegram.fallback_plain", description))
+			slog.Warn("telegram markup rejected, sent as plain text", "description", description)
+		},
+	}
+}
+
+// 📤 Отправка в Telegram
+// text — отчёт с маркерами разметки (renderReport); длинный отчёт уходит
+// несколькими сообщениями (errNotConfigured — нет токена или чата)
+func (tr *tracker) sendToTelegram(text string) error {
+	token := os.Getenv("TELEGRAM_TOKEN")
+	chatId := os.Getenv("TELEGRAM_CHAT_ID")
+
+	if token == "" || chatId == "" {
+		fmt.Println(tr.T("telegram.no_tokens"))
+		return errNotConfigured
+	}
+
+	parts, err := tr.telegramClient(token).Deliver(chatId, text)
+	if err != nil {
+		fmt.Println(tr.T("telegram.send_error", tr.telegramError(err)))
+		slog.Error("telegram send failed", "chat", chatId, "err", err)
+		return err
+	}
+
+	if parts > 1 {
+		fmt.Println(tr.T("telegram.sent_parts", parts))
+	} else {
+		fmt.Println(tr.T("telegram.sent"))
+	}
+	return nil
+}
+
+// ❌ Ошибка Bot API на текущем языке
+func (tr *tracker) telegramError(err error) string {
+	var apiErr *notify.APIError
+	if errors.As(err, &apiErr) {
+		return tr.T("telegram.bad_status", apiErr.Status, apiErr.Description)
+	}
+	return err.Error()
+}
@@ -0,0 +1,351 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/yourusername/go-learning-tracker/tracker/achievements"
+	"github.com/yourusername/go-learning-tracker/tracker/analyzer"
+	"github.com/yourusername/go-learning-tracker/tracker/clock"
+	"github.com/yourusername/go-learning-tracker/tracker/config"
+	"github.com/yourusername/go-learning-tracker/tracker/engine"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 🧰 Трекер: настройки, язык, часы и файлы, текущий ученик и syllabus
+// с результатами его анализа. Передаётся во все команды и отчёты; в тестах
+// у каждого теста свой (clock.Fixed, store.MemFS, git без git)
+type tracker struct {
+	config config.Config
+	lang   string
+	clock  clock.Clock
+	fs     store.FS
+
+	// 📁 Каталог с кодом и состоянием текущего ученика
+	// "." — обычный режим, learners/<имя> — team mode (или TRACKER_LEARNER)
+	dir string
+
+	// 📚 Программа обучения с результатами анализа текущего ученика
+	syllabus []analyzer.Topic
+
+	// 🧾 Итог запуска (копится по ходу main и runTeam, общий для всех учеников)
+	result *RunResult
+
+	// 📜 Откуда берутся новые коммиты и активность по дням (в тестах — без git)
+	commits  func(tr *tracker, last string) (commitRange, error)
+	activity func(tr *tracker, since time.Time) map[string]*ActivityDay
+}
+
+// 🆕 Трекер по умолчанию: системное время, текущий каталог, настройки по умолчанию
+func newTracker() *tracker {
+	result := newRunResult()
+	return &tracker{
+		config:   config.Default(),
+		lang:     defaultLang,
+		clock:    clock.System{},
+		fs:       store.DirFS("."),
+		dir:      ".",
+		syllabus: analyzer.DefaultSyllabus(),
+		result:   &result,
+		commits:  (*tracker).gitCommitsSince,
+		activity: (*tracker).gitActivity,
+	}
+}
+
+// 👤 Трекер для ученика в каталоге dir: настройки, файлы и итог запуска
+// общие, а syllabus — свой, со своим анализом
+func (tr *tracker) learner(dir string) *tracker {
+	learner := *tr
+	learner.dir = dir
+	learner.syllabus = analyzer.DefaultSyllabus()
+	return &learner
+}
+
+func (tr *tracker) learnerStore() store.Store { return store.New(tr.fs, tr.dir) }
+
+// ❌ Запуск не состоялся: статистика не изменена
+var (
+	errNoGoFiles    = errors.New("no Go files")
+	errCorruptState = errors.New("corrupt state")
+)
+
+// 🧮 Один запуск для текущего ученика: анализ кода, XP, streak и
+// достижения; статистика сохраняется, отчёт возвращается для отправки
+func (tr *tracker) trackProgress() (ReportData, error) {
+	now := tr.clock.Now()
+
+	// Повреждённое состояние не перезаписываем — его нужно починить руками
+	if err := tr.learnerStore().Check(); err != nil {
+		fmt.Println(tr.T("run.corrupt_state", err))
+		slog.Error("corrupt state", "learner", tr.getUsername(), "err", err)
+		return ReportData{}, fmt.Errorf("%w: %v", errCorruptState, err)
+	}
+
+	// Читаем статистику
+	stats := tr.loadStats()
+
+	files := tr.analyzeCodebase(true)
+	if files == 0 {
+		fmt.Println(tr.T("run.no_go_files"))
+		slog.Warn("no Go files", "learner", stats.Username, "dir", tr.dir)
+		return ReportData{}, errNoGoFiles
+	}
+	slog.Info("code analyzed", "learner", stats.Username, "files", files)
+
+	// Kata mode: тема засчитывается только после прохождения упражнений
+	var kataResults []KataResult
+	if tr.kataModeEnabled() {
+		fmt.Println("\n" + tr.T("kata.checking"))
+		for _, kata := range tr.discoverKatas(exercisesDir) {
+			result := runKata(kata)
+			if result.Passed {
+				fmt.Printf("  ✅ %s\n", kata.Name)
+			} else {
+				fmt.Println(tr.T("kata.topic_blocked", kata.Name, tr.topicDisplayName(kata.Topic)))
+			}
+			kataResults = append(kataResults, result)
+		}
+		tr.applyKataResults(kataResults)
+		stats.PassedKatas = passedKatas(kataResults)
+	}
+
+	// Новые коммиты: счётчик и проверка на накрутку (integrity)
+	commits, flags := tr.auditCommits(&stats, now)
+
+	// Решения ментора: review mode и темы, придержанные integrity
+	var reviews map[string]Review
+	if tr.needsReviews(stats, flags) {
+		reviews = latestReviews(tr.loadReviews(stats.Username))
+	}
+
+	// Считаем прогресс и начисляем XP
+	prevCompleted := tr.loadPreviousState()
+	rules := tr.engineRules()
+	run := engine.Run(engine.Input{
+		Stats:     stats,
+		Topics:    tr.syllabus,
+		Previous:  prevCompleted,
+		Now:       now,
+		Commits:   commits,
+		Flags:     flags,
+		Approved:  approvedTopics(reviews),
+		Standings: tr.seasonStandings,
+		Localize: func(ach achievements.Achievement) achievements.Achievement {
+			return tr.localizeAchievement(ach, rules.XP.Achievements)
+		},
+	}, rules)
+	tr.syllabus, stats = run.Topics, run.Stats
+	xp := run.XP
+	for _, id := range run.NewTopics {
+		xp.NewTopics = append(xp.NewTopics, tr.topicDisplayName(id))
+	}
+
+	if run.SeasonEnd != nil {
+		fmt.Println(tr.T("season.finished", run.SeasonEnd.ID, run.SeasonEnd.NewLeague, run.SeasonEnd.Reward))
+	}
+	if xp.Penalty > 0 {
+		fmt.Println(tr.T("run.penalty", xp.Penalty, stats.PenaltyDays))
+	}
+
+	// Отказы и темы на проверке — и в review mode, и придержанные integrity
+	rejections := tr.unseenRejections(&stats, reviews)
+	for _, name := range tr.pendingReviewTopics() {
+		fmt.Println(tr.T("review.topic_pending", name))
+	}
+	if rules.Integrity && len(run.Flags) > 0 && len(run.Flags[0].Topics) > 0 {
+		fmt.Println(tr.T("integrity.topics_held", len(run.Flags[0].Topics)))
+	}
+
+	for _, id := range run.NewTopics {
+		topic, _ := analyzer.Find(tr.syllabus, id)
+		fmt.Println(tr.T("run.new_topic", tr.topicName(topic), topic.XPReward))
+	}
+
+	// Темы были удалены, но XP НЕ отнимаем (это честно заработано)
+	if run.Missing > 0 {
+		fmt.Println(tr.T("run.topics_missing", run.Missing))
+		fmt.Println(tr.T("run.xp_kept"))
+	}
+
+	if xp.Streak > 0 {
+		fmt.Println(tr.T("run.streak_bonus", xp.Streak, stats.CurrentStreak))
+	}
+	for _, ach := range run.NewAchievements {
+		fmt.Println(tr.T("run.achievement_unlocked", ach.Name, ach.XPReward))
+	}
+
+	// Следующая цель и подсказка к ней
+	nextTopic := tr.T("run.all_done")
+	var hintText string
+	if topic := tr.nextIncompleteTopic(); topic != nil {
+		nextTopic = tr.topicName(*topic)
+		hint := tr.buildHint(*topic, tr.loadHintBank(hintsDir), stats.TotalCommits)
+		hint.Katas = pendingKatas(topic.ID, kataResults)
+		hint.Review = topicReview(*topic, reviews)
+		hintText = tr.formatHint(hint)
+	}
+
+	// Сохраняем текущее состояние
+	tr.saveCurrentState()
+
+	// Сохраняем статистику
+	tr.saveStats(stats)
+
+	// Данные для шаблонов отчёта
+	report := tr.newReportData(stats, run.Completed, len(tr.syllabus))
+	report.NextTopic = nextTopic
+	report.Hint = hintText
+	report.NewAchievements = run.NewAchievements
+	report.XP = xp
+	report.SeasonEnd = run.SeasonEnd
+	report.PendingReview = tr.pendingReviewTopics()
+	report.Rejections = rejections
+	report.IntegrityFlags = run.Flags
+	report.Files = files
+	report.NewTopicIDs = run.NewTopics
+
+	slog.Info("progress saved", "learner", stats.Username, "xp", xp.Net(), "total_xp", stats.TotalXP,
+		"level", stats.Level, "new_topics", run.NewTopics, "completed", run.Completed)
+	return report, nil
+}
+
+// ⚖️ Правила запуска из настроек
+func (tr *tracker) engineRules() engine.Rules {
+	return engine.Rules{
+		XP:          tr.config.Rules(),
+		Progression: tr.progression(),
+		Season:      tr.seasonLength(),
+		Review:      tr.reviewModeEnabled(),
+		Integrity:   tr.integrityEnabled(),
+	}
+}
+
+// 📊 Загрузка статистики (новый ученик — пустая статистика)
+func (tr *tracker) loadStats() store.UserStats {
+	stats, err := tr.learnerStore().LoadStats()
+	if errors.Is(err, fs.ErrNotExist) {
+		return store.NewStats(tr.getUsername())
+	}
+	return stats
+}
+
+// 💾 Сохранение статистики
+func (tr *tracker) saveStats(stats store.UserStats) {
+	if err := tr.learnerStore().SaveStats(stats); err != nil {
+		fmt.Println(tr.T("run.save_error", store.StatsFile, err))
+		slog.Error("file not written", "path", tr.learnerStore().Path(store.StatsFile), "err", err)
+	}
+}
+
+// 📝 Загрузка предыдущего состояния
+func (tr *tracker) loadPreviousState() []string {
+	topics := tr.learnerStore().LoadCompleted()
+
+	// Старый формат хранил названия тем — переводим их в ID
+	for i, topic := range topics {
+		if id, ok := tr.topicIDByLegacyName(topic); ok {
+			topics[i] = id
+		}
+	}
+	return topics
+}
+
+// 💾 Сохранение текущего состояния
+func (tr *tracker) saveCurrentState() {
+	if err := tr.learnerStore().SaveCompleted(analyzer.CompletedIDs(tr.syllabus)); err != nil {
+		fmt.Println(tr.T("run.save_error", store.CompletedFile, err))
+		slog.Error("file not written", "path", tr.learnerStore().Path(store.CompletedFile), "err", err)
+	}
+}
+
+// ✅ Изучена ли тема (по текущему анализу)
+func (tr *tracker) isTopicCompleted(id string) bool {
+	return analyzer.IsCompleted(tr.syllabus, id)
+}
+
+// 📚 Название темы по ID (для тем из упражнений и банка подсказок)
+func (tr *tracker) topicDisplayName(id string) string {
+	if topic, ok := analyzer.Find(tr.syllabus, id); ok {
+		return tr.topicName(topic)
+	}
+	return id
+}
+
+// 🔝 Максимальный уровень в программе обучения
+func (tr *tracker) maxLevel() int {
+	return analyzer.MaxLevel(tr.syllabus)
+}
+
+// 👤 Получение username (в team mode — имя каталога ученика)
+func (tr *tracker) getUsername() string {
+	if tr.dir != "." {
+		return filepath.Base(tr.dir)
+	}
+	username := os.Getenv("GITHUB_ACTOR")
+	if username == "" {
+		username = "GoLearner"
+	}
+	return username
+}
+
+// 📄 Файл с учебным кодом: .go вне трекера, .git и упражнений
+func (tr *tracker) isLearningFile(path string) bool {
+	slashed := filepath.ToSlash(path)
+	if strings.Contains(path, "notifier") || strings.Contains(path, ".git") || strings.HasPrefix(slashed, "tracker/") {
+		return false
+	}
+	// Заготовки и скрытые тесты упражнений проверяются отдельно (kata mode)
+	if strings.HasPrefix(slashed, exercisesDir+"/") {
+		return false
+	}
+	// В team mode — только код текущего ученика
+	if tr.dir != "." && !strings.HasPrefix(slashed, filepath.ToSlash(tr.dir)+"/") {
+		return false
+	}
+	return strings.HasSuffix(path, ".go")
+}
+
+// 🔬 Анализ всех .go файлов (возвращает количество файлов)
+func (tr *tracker) analyzeCodebase(verbose bool) int {
+	files := analyzer.FindGoFiles(tr.fs, tr.dir, tr.isLearningFile)
+	if len(files) == 0 {
+		return 0
+	}
+
+	if verbose {
+		fmt.Println(tr.T("analyze.files_found", len(files)))
+	}
+
+	for _, file := range analyzer.Analyze(tr.syllabus, tr.fs, files) {
+		if !verbose {
+			continue
+		}
+		fmt.Println("\n" + tr.T("analyze.file", file.Path))
+		for _, match := range file.Matches {
+			if match.AST {
+				fmt.Println(tr.T("analyze.pattern", match.Pattern, match.Count))
+			} else {
+				fmt.Println(tr.T("analyze.keyword", match.Pattern, match.Count))
+			}
+		}
+		if file.ParseErr != nil {
+			fmt.Println(tr.T("analyze.ast_error", file.ParseErr))
+		}
+	}
+
+	return len(files)
+}
+
+// 🏆 Название уровня (Фэнтези стиль, locales/level.<n>)
+func (tr *tracker) getLevelName(level int) string {
+	if level < 1 || level > tr.maxLevel() {
+		level = 1
+	}
+	return tr.T(fmt.Sprintf("level.%d", level))
+}
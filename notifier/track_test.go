@@ -0,0 +1,239 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yourusername/go-learning-tracker/tracker/analyzer"
+	"github.com/yourusername/go-learning-tracker/tracker/clock"
+	"github.com/yourusername/go-learning-tracker/tracker/integrity"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 📄 Учебный код: изучены «Типы данных» и «Переменные и константы»
+const basicsSource = `package main
+
+import "fmt"
+
+var a int = 1
+var b float64 = 2
+var c string = "x"
+var d bool
+var e int
+var f int
+var g int
+var i int
+const h int = 8
+
+func main() { fmt.Println(a, b, c, d, e, f, g, h, i) }
+`
+
+// 📄 Четыре условия: тема «Условия» ещё не изучена (4 из 8)
+const conditionsSource = `package main
+
+func sign(x int) int {
+	if x > 0 {
+		return 1
+	} else if x < 0 {
+		return -1
+	}
+	return 0
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+`
+
+// 🧪 Трекер на файлах в памяти и фиксированных часах, без git
+// (файлы — в tr.fs, это store.MemFS)
+func newTestTracker(t *testing.T, date string, files map[string]string) *tracker {
+	t.Helper()
+
+	memfs := store.MemFS{}
+	for name, src := range files {
+		memfs.WriteFile(name, []byte(src), 0644)
+	}
+
+	tr := newTracker()
+	tr.fs = memfs
+	tr.clock = clock.At(date)
+	tr.activity = func(*tracker, time.Time) map[string]*ActivityDay { return map[string]*ActivityDay{} }
+	// Каждый запуск — один новый коммит, без git
+	tr.commits = func(*tracker, string) (commitRange, error) {
+		return commitRange{Commits: []integrity.Commit{{Hash: "test"}}}, nil
+	}
+
+	for _, env := range []string{
+		"LEADERBOARD_WEBHOOK", "TRACKER_CONFIG", "TRACKER_SEASON", "TRACKER_KATA", "TRACKER_REVIEW",
+		"LEVEL_MODEL", "LEVEL_CURVE", "HEATMAP_METRIC",
+		"REPORT_TEMPLATE_CONSOLE", "REPORT_TEMPLATE_BOT", "REPORT_TEMPLATE_MARKDOWN",
+		"REPORT_TEMPLATE_DIGEST", "REPORT_TEMPLATE_TEAM",
+	} {
+		t.Setenv(env, "")
+	}
+	t.Setenv("GITHUB_ACTOR", "alice")
+
+	return tr
+}
+
+// 📅 Запуск трекера в указанный день
+func trackOn(t *testing.T, tr *tracker, date string) ReportData {
+	t.Helper()
+	tr.clock = clock.At(date)
+	report, err := tr.trackProgress()
+	if err != nil {
+		t.Fatalf("%s: %v", date, err)
+	}
+	return report
+}
+
+// 📆 Коммиты каждый день через границу месяца, затем пропуск
+func TestTrackProgressAcrossMonths(t *testing.T) {
+	tr := newTestTracker(t, "2026-01-30", map[string]string{"basics/main.go": basicsSource})
+	memfs := tr.fs.(store.MemFS)
+
+	day1 := trackOn(t, tr, "2026-01-30")
+	// 2 темы по 50 XP + streak 20 XP + «Первый коммит» 100 XP
+	if day1.Stats.TotalXP != 220 || day1.Stats.CurrentStreak != 1 || day1.Stats.Season != "2026-01" {
+		t.Fatalf("day 1: %+v", day1.Stats)
+	}
+	if len(day1.XP.NewTopics) != 2 || len(day1.NewAchievements) != 1 {
+		t.Fatalf("day 1: topics %v, achievements %v", day1.XP.NewTopics, day1.NewAchievements)
+	}
+
+	memfs.WriteFile("basics/conditions.go", []byte(conditionsSource), 0644)
+	day2 := trackOn(t, tr, "2026-01-31")
+	if day2.Stats.TotalXP != 260 || day2.Stats.CurrentStreak != 2 || day2.XP.Topics != 0 {
+		t.Fatalf("day 2: %+v", day2.Stats)
+	}
+
+	// 1 февраля: streak продолжается, январь закрыт (без соперников —
+	// мало сезонного XP, Bronze остаётся Bronze)
+	day3 := trackOn(t, tr, "2026-02-01")
+	if day3.Stats.CurrentStreak != 3 || day3.Stats.Season != "2026-02" || day3.SeasonEnd == nil {
+		t.Fatalf("day 3: %+v, season end %+v", day3.Stats, day3.SeasonEnd)
+	}
+	if day3.SeasonEnd.ID != "2026-01" || day3.SeasonEnd.XP != 260 || day3.Stats.League != "🥉 Bronze" {
+		t.Errorf("season end: %+v", day3.SeasonEnd)
+	}
+	if day3.Stats.TotalXP != 260+60+day3.SeasonEnd.Reward {
+		t.Errorf("day 3: TotalXP = %d", day3.Stats.TotalXP)
+	}
+
+	// Три пропущенных дня: штраф 90 XP, серия с начала
+	day4 := trackOn(t, tr, "2026-02-05")
+	if day4.Stats.CurrentStreak != 1 || day4.Stats.LongestStreak != 3 || day4.Stats.PenaltyDays != 3 || day4.XP.Penalty != 90 {
+		t.Fatalf("day 4: %+v", day4.Stats)
+	}
+	if day4.Stats.TotalXP != day3.Stats.TotalXP-90+20 {
+		t.Errorf("day 4: TotalXP = %d, want %d", day4.Stats.TotalXP, day3.Stats.TotalXP-70)
+	}
+
+	// Статистика и история сохранены в MemFS
+	saved, err := tr.learnerStore().LoadStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(saved.History) != 4 || saved.History[3].Date != "2026-02-05" || saved.TotalXP != day4.Stats.TotalXP {
+		t.Errorf("saved: %+v", saved)
+	}
+	if completed := string(memfs[store.CompletedFile].Data); completed != `["types","variables"]` {
+		t.Errorf("%s = %s", store.CompletedFile, completed)
+	}
+}
+
+// 🔁 Повторный запуск в тот же день: streak и темы не начисляются заново
+func TestTrackProgressSameDay(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", map[string]string{"basics/main.go": basicsSource})
+
+	first := trackOn(t, tr, "2026-03-01")
+	second := trackOn(t, tr, "2026-03-01")
+
+	if second.Stats.CurrentStreak != 1 || second.XP.Topics != 0 || len(second.NewAchievements) != 0 {
+		t.Fatalf("second run: %+v", second)
+	}
+	if second.Stats.TotalXP != first.Stats.TotalXP+20 {
+		t.Errorf("TotalXP = %d, want %d", second.Stats.TotalXP, first.Stats.TotalXP+20)
+	}
+	if history := second.Stats.History; len(history) != 1 || history[0].Runs != 2 {
+		t.Errorf("History = %+v", history)
+	}
+}
+
+// 🚫 Код трекера и упражнений не считается учебным
+func TestIsLearningFile(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", nil)
+
+	tests := map[string]bool{
+		"basics/main.go":         true,
+		"main.go":                true,
+		"basics/notes.md":        false,
+		"notifier/main.go":       false,
+		"tracker/store/store.go": false,
+		"exercises/sum/sum.go":   false,
+	}
+	for path, want := range tests {
+		if got := tr.isLearningFile(path); got != want {
+			t.Errorf("isLearningFile(%q) = %v, want %v", path, got, want)
+		}
+	}
+
+	tr.dir = "learners/alice"
+	if tr.isLearningFile("learners/bob/main.go") || !tr.isLearningFile("learners/alice/main.go") {
+		t.Error("team mode: only the current learner's code counts")
+	}
+}
+
+// 👤 Трекер ученика: свой каталог и анализ, общие настройки и итог запуска
+func TestLearnerTracker(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", map[string]string{"learners/bob/main.go": basicsSource})
+	tr.config.FocusTopics = 7
+
+	bob := tr.learner("learners/bob")
+	if _, err := bob.trackProgress(); err != nil {
+		t.Fatal(err)
+	}
+	bob.recordLearner(ReportData{Stats: store.NewStats("bob")})
+
+	if tr.dir != "." || analyzer.IsCompleted(tr.syllabus, "types") || !analyzer.IsCompleted(bob.syllabus, "types") {
+		t.Errorf("learner run changed the parent: dir %q", tr.dir)
+	}
+	if bob.config.FocusTopics != 7 || len(tr.result.Learners) != 1 {
+		t.Errorf("shared state: focus %d, learners %+v", bob.config.FocusTopics, tr.result.Learners)
+	}
+}
+
+// 🧪 Настройки из окружения поверх значений по умолчанию
+func loadTestConfig(t *testing.T, tr *tracker) {
+	t.Helper()
+	cfg, _, err := tr.loadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr.config = cfg
+}
+
+func TestProgressionFromEnv(t *testing.T) {
+	tr := newTestTracker(t, "2026-03-01", nil)
+	t.Setenv("LEVEL_MODEL", "xp")
+	t.Setenv("LEVEL_CURVE", "table:0,100,200")
+	loadTestConfig(t, tr)
+
+	if level := tr.computeLevel(store.UserStats{TotalXP: 150}); level != 2 {
+		t.Errorf("computeLevel = %d, want 2", level)
+	}
+	if next := tr.levelProgress(store.UserStats{Level: 2, TotalXP: 150}); next.XPToNext != 50 {
+		t.Errorf("levelProgress = %+v", next)
+	}
+
+	// Неверная кривая — ошибка при загрузке, а не молчаливая замена
+	t.Setenv("LEVEL_CURVE", "exp:0")
+	if _, _, err := tr.loadConfig(); err == nil || !strings.Contains(err.Error(), "level_curve") {
+		t.Errorf("bad curve: err = %v", err)
+	}
+}
@@ -0,0 +1,116 @@
+// Package achievements описывает достижения и условия их получения.
+package achievements
+
+import "github.com/yourusername/go-learning-tracker/tracker/analyzer"
+
+// 🏆 ДОСТИЖЕНИЯ
+type Achievement struct {
+	ID          string
+	Name        string
+	Description string
+	Icon        string
+	XPReward    int
+	Unlocked    bool
+	Category    string `json:"-"` // ID раздела в ACHIEVEMENTS.md (category.<id> в locales)
+	Tip         string `json:"-"` // Совет, как получить (заполняет локализация)
+}
+
+// 🏆 Список всех достижений (названия и описания — в locales/achievement.<id>.*)
+func All() []Achievement {
+	return []Achievement{
+		{ID: "first_commit", Icon: "🎯", XPReward: 100, Category: "start"},
+		{ID: "week_streak", Icon: "🔥", XPReward: 300, Category: "streak"},
+		{ID: "month_streak", Icon: "💪", XPReward: 1000, Category: "streak"},
+		{ID: "level_3", Icon: "🥉", XPReward: 200, Category: "levels"},
+		{ID: "level_5", Icon: "🥈", XPReward: 500, Category: "levels"},
+		{ID: "level_7", Icon: "🥇", XPReward: 1000, Category: "levels"},
+		{ID: "maps_master", Icon: "🗺️", XPReward: 250, Category: "tech"},
+		{ID: "concurrency_king", Icon: "⚡", XPReward: 400, Category: "tech"},
+		{ID: "error_handler", Icon: "🛡️", XPReward: 300, Category: "tech"},
+		{ID: "hundred_commits", Icon: "💯", XPReward: 2000, Category: "start"},
+		{ID: "level_10", Icon: "💠", XPReward: 1500, Category: "levels"},
+		{ID: "level_12", Icon: "🐉", XPReward: 3000, Category: "levels"},
+		{ID: "generics_master", Icon: "🧬", XPReward: 500, Category: "tech"},
+		{ID: "context_master", Icon: "⏳", XPReward: 500, Category: "tech"},
+		{ID: "sync_master", Icon: "🔐", XPReward: 500, Category: "tech"},
+		{ID: "bench_master", Icon: "🏎️", XPReward: 600, Category: "tech"},
+	}
+}
+
+// 📊 Что нужно знать о прогрессе ученика для проверки достижений
+type Progress struct {
+	TotalCommits  int
+	CurrentStreak int
+	Level         int
+	Topics        []analyzer.Topic // Программа с результатами последнего анализа
+}
+
+// 🛡️ Защита от читеров: минимум коммитов (дней учёбы) для достижений уровня
+type Rules struct {
+	LevelCommits map[int]int // Уровень → коммиты
+}
+
+// 📦 Правила по умолчанию (каждый вызов — новая копия)
+func DefaultRules() Rules {
+	return Rules{LevelCommits: map[int]int{3: 10, 5: 25, 7: 50, 10: 75, 12: 100}}
+}
+
+// 🏆 Новые достижения: условие выполнено, а в unlocked их ещё нет
+// Тексты не заполнены — их подставляет локализация вызывающего
+func Check(progress Progress, unlocked []Achievement, rules Rules) []Achievement {
+	have := map[string]bool{}
+	for _, ach := range unlocked {
+		have[ach.ID] = true
+	}
+
+	var newAchievements []Achievement
+	for _, achievement := range All() {
+		if !have[achievement.ID] && earned(achievement.ID, progress, rules) {
+			newAchievements = append(newAchievements, achievement)
+		}
+	}
+	return newAchievements
+}
+
+// ✅ Условие достижения
+func earned(id string, progress Progress, rules Rules) bool {
+	topics := progress.Topics
+
+	switch id {
+	case "first_commit":
+		return progress.TotalCommits >= 1
+	case "week_streak":
+		return progress.CurrentStreak >= 7
+	case "month_streak":
+		return progress.CurrentStreak >= 30
+	case "level_3":
+		return progress.Level >= 3 && progress.TotalCommits >= rules.LevelCommits[3]
+	case "level_5":
+		return progress.Level >= 5 && progress.TotalCommits >= rules.LevelCommits[5]
+	case "level_7":
+		return progress.Level >= 7 && progress.TotalCommits >= rules.LevelCommits[7]
+	case "level_10":
+		return progress.Level >= 10 && progress.TotalCommits >= rules.LevelCommits[10]
+	case "level_12":
+		return progress.Level >= 12 && progress.TotalCommits >= rules.LevelCommits[12]
+	case "generics_master":
+		return analyzer.IsCompleted(topics, "generics")
+	case "context_master":
+		return analyzer.IsCompleted(topics, "context")
+	case "sync_master":
+		return analyzer.IsCompleted(topics, "sync")
+	case "bench_master":
+		return analyzer.IsCompleted(topics, "benchmarks")
+	case "maps_master":
+		topic, ok := analyzer.Find(topics, "maps")
+		return ok && topic.Found >= 10
+	case "concurrency_king":
+		return analyzer.IsCompleted(topics, "goroutines") && analyzer.IsCompleted(topics, "channels")
+	case "error_handler":
+		topic, ok := analyzer.Find(topics, "errors")
+		return ok && topic.Found >= 20
+	case "hundred_commits":
+		return progress.TotalCommits >= 100
+	}
+	return false
+}
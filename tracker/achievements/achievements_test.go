@@ -0,0 +1,101 @@
+package achievements
+
+import (
+	"testing"
+
+	"github.com/yourusername/go-learning-tracker/tracker/analyzer"
+)
+
+// 📚 Программа, в которой у тем найдено столько примеров
+func topicsWith(found map[string]int) []analyzer.Topic {
+	topics := analyzer.DefaultSyllabus()
+	for i := range topics {
+		topics[i].Found = found[topics[i].ID]
+	}
+	return topics
+}
+
+func ids(achievements []Achievement) map[string]bool {
+	set := map[string]bool{}
+	for _, ach := range achievements {
+		set[ach.ID] = true
+	}
+	return set
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		progress Progress
+		want     []string
+		notWant  []string
+	}{
+		{"ничего", Progress{Topics: topicsWith(nil)}, nil, []string{"first_commit"}},
+		{"первый коммит", Progress{TotalCommits: 1, Topics: topicsWith(nil)}, []string{"first_commit"}, []string{"week_streak"}},
+		{"неделя", Progress{TotalCommits: 7, CurrentStreak: 7, Topics: topicsWith(nil)}, []string{"week_streak"}, []string{"month_streak"}},
+		{"месяц", Progress{TotalCommits: 30, CurrentStreak: 30, Topics: topicsWith(nil)}, []string{"week_streak", "month_streak"}, nil},
+		// Защита от читеров: уровня мало, нужны ещё дни коммитов
+		{"level 3 слишком быстро", Progress{TotalCommits: 9, Level: 3, Topics: topicsWith(nil)}, nil, []string{"level_3"}},
+		{"level 3", Progress{TotalCommits: 10, Level: 3, Topics: topicsWith(nil)}, []string{"level_3"}, []string{"level_5"}},
+		{"карты", Progress{Topics: topicsWith(map[string]int{"maps": 10})}, []string{"maps_master"}, nil},
+		{"карт мало", Progress{Topics: topicsWith(map[string]int{"maps": 9})}, nil, []string{"maps_master"}},
+		{"только горутины", Progress{Topics: topicsWith(map[string]int{"goroutines": 5})}, nil, []string{"concurrency_king"}},
+		{"горутины и каналы", Progress{Topics: topicsWith(map[string]int{"goroutines": 5, "channels": 8})}, []string{"concurrency_king"}, nil},
+		{"дженерики", Progress{Topics: topicsWith(map[string]int{"generics": 5})}, []string{"generics_master"}, nil},
+		{"сто коммитов", Progress{TotalCommits: 100, Topics: topicsWith(nil)}, []string{"hundred_commits"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ids(Check(tt.progress, nil, DefaultRules()))
+			for _, id := range tt.want {
+				if !got[id] {
+					t.Errorf("%s not unlocked (got %v)", id, got)
+				}
+			}
+			for _, id := range tt.notWant {
+				if got[id] {
+					t.Errorf("%s unlocked too early", id)
+				}
+			}
+		})
+	}
+}
+
+// 🔁 Уже полученное достижение не выдаётся повторно
+func TestCheckUnlockedOnce(t *testing.T) {
+	progress := Progress{TotalCommits: 7, CurrentStreak: 7, Topics: topicsWith(nil)}
+
+	first := Check(progress, nil, DefaultRules())
+	if len(first) != 2 {
+		t.Fatalf("first run: %v", ids(first))
+	}
+	if again := Check(progress, first, DefaultRules()); len(again) != 0 {
+		t.Errorf("second run: %v", ids(again))
+	}
+}
+
+func TestAllUnique(t *testing.T) {
+	seen := map[string]bool{}
+	for _, ach := range All() {
+		if seen[ach.ID] {
+			t.Errorf("duplicate achievement %s", ach.ID)
+		}
+		seen[ach.ID] = true
+		if ach.XPReward <= 0 || ach.Icon == "" || ach.Category == "" {
+			t.Errorf("incomplete achievement %+v", ach)
+		}
+	}
+}
+
+// ⚙️ Минимум коммитов для уровня берётся из правил (tracker.json)
+func TestCheckRules(t *testing.T) {
+	progress := Progress{TotalCommits: 5, Level: 3, Topics: topicsWith(nil)}
+
+	if got := ids(Check(progress, nil, DefaultRules())); got["level_3"] {
+		t.Errorf("level_3 with default rules: %v", got)
+	}
+	if got := ids(Check(progress, nil, Rules{LevelCommits: map[int]int{3: 5}})); !got["level_3"] {
+		t.Errorf("level_3 not unlocked with level_commits {3: 5}: %v", got)
+	}
+}
@@ -0,0 +1,636 @@
+// Package analyzer ищет в учебном коде темы программы обучения:
+// по ключевым словам в тексте и по AST-паттернам.
+package analyzer
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"regexp"
+	"strings"
+)
+
+// 🎯 СТРУКТУРА ОБУЧЕНИЯ
+type Topic struct {
+	Level         int
+	ID            string // Стабильный ASCII-идентификатор (название — в locales)
+	Keywords      []string
+	Detectors     []string // AST-паттерны (см. PatternCount)
+	MinExamples   int
+	XPReward      int // XP за изучение темы
+	Found         int
+	Counts        map[string]int // Найдено по каждому ключевому слову/паттерну
+	KataPending   bool           // Есть непройденные упражнения (kata mode)
+	ReviewPending bool           // Ждёт одобрения ментора (review mode)
+}
+
+// ✅ Тема изучена: достаточно примеров, пройдены упражнения и одобрена
+func (t Topic) Completed() bool {
+	return t.Found >= t.MinExamples && !t.KataPending && !t.ReviewPending
+}
+
+// 📚 Программа обучения по умолчанию (каждый вызов — новая копия без результатов)
+func DefaultSyllabus() []Topic {
+	return []Topic{
+		// LEVEL 1: Новобранец (10-15 дней реального обучения)
+		{Level: 1, ID: "types", Keywords: []string{"int", "float", "string", "bool"}, MinExamples: 10, XPReward: 50},
+		{Level: 1, ID: "variables", Keywords: []string{"var ", "const "}, MinExamples: 8, XPReward: 50},
+
+		// LEVEL 2: Подмастерье (еще 10-15 дней)
+		{Level: 2, ID: "conditions", Keywords: []string{"if ", "else"}, MinExamples: 8, XPReward: 75},
+		{Level: 2, ID: "loops", Keywords: []string{"for "}, MinExamples: 8, XPReward: 75},
+		{Level: 2, ID: "switch", Keywords: []string{"switch "}, MinExamples: 3, XPReward: 75},
+
+		// LEVEL 3: Искатель (еще 10 дней)
+		{Level: 3, ID: "slices", Keywords: []string{"[]", "make([]", "append("}, MinExamples: 10, XPReward: 100},
+		{Level: 3, ID: "maps", Keywords: []string{"map[", "make(map"}, MinExamples: 8, XPReward: 100},
+
+		// LEVEL 4: Следопыт (еще 10 дней)
+		{Level: 4, ID: "functions", Keywords: []string{"func "}, MinExamples: 10, XPReward: 125},
+		{Level: 4, ID: "errors", Keywords: []string{"error", "if err != nil"}, MinExamples: 8, XPReward: 125},
+
+		// LEVEL 5: Чародей (еще 15 дней)
+		{Level: 5, ID: "structs", Keywords: []string{"type ", "struct"}, MinExamples: 8, XPReward: 150},
+		{Level: 5, ID: "methods", Keywords: []string{") func", "receiver"}, MinExamples: 8, XPReward: 150},
+		{Level: 5, ID: "interfaces", Keywords: []string{"interface"}, MinExamples: 5, XPReward: 150},
+
+		// LEVEL 6: Архимаг (еще 15 дней)
+		{Level: 6, ID: "goroutines", Keywords: []string{"go func", "go "}, MinExamples: 5, XPReward: 200},
+		{Level: 6, ID: "channels", Keywords: []string{"chan ", "<-"}, MinExamples: 8, XPReward: 200},
+
+		// LEVEL 7: Великий Магистр (финал, еще 20 дней)
+		{Level: 7, ID: "http", Keywords: []string{"http.HandleFunc", "http.ListenAndServe"}, MinExamples: 5, XPReward: 250},
+		{Level: 7, ID: "testing", Keywords: []string{"func Test", "t.Error"}, MinExamples: 5, XPReward: 250},
+
+		// LEVEL 8: Рунмейстер (дженерики и композиция типов)
+		{Level: 8, ID: "generics", Detectors: []string{"node:type_params", "node:constraint"}, MinExamples: 5, XPReward: 300},
+		{Level: 8, ID: "embedding", Detectors: []string{"node:embedded"}, MinExamples: 4, XPReward: 300},
+
+		// LEVEL 9: Хранитель Печатей (надёжность)
+		{Level: 9, ID: "defer", Detectors: []string{"stmt:defer", "call:panic", "call:recover"}, MinExamples: 6, XPReward: 350},
+		{Level: 9, ID: "error_wrapping", Detectors: []string{"call:errors.Is", "call:errors.As", "call:errors.Unwrap", "node:error_wrap"}, MinExamples: 5, XPReward: 350},
+
+		// LEVEL 10: Повелитель Стихий (конкурентность продвинутого уровня)
+		{Level: 10, ID: "context", Detectors: []string{"sel:context.*"}, MinExamples: 8, XPReward: 400},
+		{Level: 10, ID: "sync", Detectors: []string{"sel:sync.Mutex", "sel:sync.RWMutex", "sel:sync.Once", "node:mutex_lock", "node:mutex_rlock"}, MinExamples: 6, XPReward: 400},
+
+		// LEVEL 11: Архитектор (композиция и модули)
+		{Level: 11, ID: "io", Detectors: []string{"sel:io.Reader", "sel:io.Writer", "call:io.Copy", "call:io.MultiReader", "call:io.TeeReader", "call:io.LimitReader", "call:bufio.NewReader", "call:bufio.NewScanner", "call:strings.NewReader"}, MinExamples: 6, XPReward: 450},
+		{Level: 11, ID: "modules", Detectors: []string{"node:library_package", "node:module_import"}, MinExamples: 3, XPReward: 450},
+
+		// LEVEL 12: Легенда Go (производительность)
+		{Level: 12, ID: "benchmarks", Detectors: []string{"node:benchmark", "node:bench_loop", "call:*.ResetTimer", "call:*.ReportAllocs"}, MinExamples: 4, XPReward: 500},
+	}
+}
+
+// 🔝 Максимальный уровень в программе обучения
+func MaxLevel(topics []Topic) int {
+	max := 1
+	for _, topic := range topics {
+		if topic.Level > max {
+			max = topic.Level
+		}
+	}
+	return max
+}
+
+// 🔎 Тема по ID
+func Find(topics []Topic, id string) (Topic, bool) {
+	for _, topic := range topics {
+		if topic.ID == id {
+			return topic, true
+		}
+	}
+	return Topic{}, false
+}
+
+// ✅ Изучена ли тема (по последнему анализу)
+func IsCompleted(topics []Topic, id string) bool {
+	topic, ok := Find(topics, id)
+	return ok && topic.Completed()
+}
+
+// 📋 ID изученных тем в порядке программы
+func CompletedIDs(topics []Topic) []string {
+	var ids []string
+	for _, topic := range topics {
+		if topic.Completed() {
+			ids = append(ids, topic.ID)
+		}
+	}
+	return ids
+}
+
+// 🔢 Найдено в файле по ключевому слову или AST-паттерну
+type Match struct {
+	Pattern string
+	Count   int
+	AST     bool // AST-паттерн, а не ключевое слово
+}
+
+// 📄 Результат анализа одного файла
+type FileReport struct {
+	Path     string
+	Matches  []Match
+	ParseErr error // Файл не разобран: AST-паттерны не проверялись
+}
+
+// 🔎 Поиск файлов для анализа в каталоге root; include решает, учебный ли это файл
+func FindGoFiles(fsys fs.FS, root string, include func(path string) bool) []string {
+	var files []string
+	fs.WalkDir(fsys, root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if !entry.IsDir() && include(path) {
+			files = append(files, path)
+		}
+		return nil
+	})
+	return files
+}
+
+// 🔬 Анализ файлов: счётчики тем обнуляются и заполняются заново
+// Нечитаемые файлы пропускаются
+func Analyze(topics []Topic, fsys fs.FS, files []string) []FileReport {
+	Reset(topics)
+
+	var reports []FileReport
+	for _, file := range files {
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			continue
+		}
+		reports = append(reports, AnalyzeSource(topics, file, data))
+	}
+	return reports
+}
+
+// 🧽 Сброс счётчиков перед новым анализом
+func Reset(topics []Topic) {
+	for i := range topics {
+		topics[i].Found = 0
+		topics[i].Counts = map[string]int{}
+	}
+}
+
+// 📊 Анализ исходника: найденное добавляется к счётчикам тем
+func AnalyzeSource(topics []Topic, filename string, src []byte) FileReport {
+	report := FileReport{Path: filename}
+	code := RemoveComments(string(src))
+
+	for i := range topics {
+		for _, keyword := range topics[i].Keywords {
+			count := strings.Count(code, keyword)
+			topics[i].add(keyword, count)
+			if count > 0 {
+				report.Matches = append(report.Matches, Match{Pattern: keyword, Count: count})
+			}
+		}
+	}
+
+	// Продвинутые темы ищем по AST — ключевых слов для них недостаточно
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, 0)
+	if err != nil {
+		report.ParseErr = err
+		return report
+	}
+
+	for i := range topics {
+		for _, pattern := range topics[i].Detectors {
+			count := PatternCount(file, pattern)
+			topics[i].add(pattern, count)
+			if count > 0 {
+				report.Matches = append(report.Matches, Match{Pattern: pattern, Count: count, AST: true})
+			}
+		}
+	}
+
+	return report
+}
+
+func (t *Topic) add(pattern string, count int) {
+	if t.Counts == nil {
+		t.Counts = map[string]int{}
+	}
+	t.Found += count
+	t.Counts[pattern] += count
+}
+
+// 🌳 Подсчёт AST-паттерна в файле
+//
+// Поддерживаемые паттерны:
+//   - call:pkg.Func / call:*.Method / call:builtin — вызовы функций и методов
+//   - sel:pkg.Name / sel:pkg.*   — обращения к идентификаторам пакета
+//   - stmt:defer / stmt:go        — операторы
+//   - node:<kind>                 — особые конструкции (см. nodeCount)
+//
+// pkg — путь импорта: засчитывается только пакет, импортированный в файле
+// (под любым именем) и не перекрытый переменной с тем же именем
+func PatternCount(file *ast.File, pattern string) int {
+	kind, target, _ := strings.Cut(pattern, ":")
+	imports := fileImports(file)
+
+	switch kind {
+	case "node":
+		return nodeCount(file, imports, target)
+	case "stmt":
+		count := 0
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch n.(type) {
+			case *ast.DeferStmt:
+				if target == "defer" {
+					count++
+				}
+			case *ast.GoStmt:
+				if target == "go" {
+					count++
+				}
+			}
+			return true
+		})
+		return count
+	case "call":
+		count := 0
+		ast.Inspect(file, func(n ast.Node) bool {
+			if call, ok := n.(*ast.CallExpr); ok && matchExpr(imports, call.Fun, target) {
+				count++
+			}
+			return true
+		})
+		return count
+	case "sel":
+		count := 0
+		ast.Inspect(file, func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok && matchExpr(imports, sel, target) {
+				count++
+			}
+			return true
+		})
+		return count
+	}
+	return 0
+}
+
+// 📦 Импорты файла: имя в коде → путь ("_" и "." не считаются)
+func fileImports(file *ast.File) map[string]string {
+	imports := map[string]string{}
+	for _, imp := range file.Imports {
+		path := strings.Trim(imp.Path.Value, "\"`")
+		name := path[strings.LastIndex(path, "/")+1:]
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		if name != "_" && name != "." {
+			imports[name] = path
+		}
+	}
+	return imports
+}
+
+// 📦 Путь пакета, на который ссылается выражение ("" — не пакет: переменная,
+// поле или неимпортированное имя)
+func packagePath(imports map[string]string, expr ast.Expr) string {
+	ident, ok := expr.(*ast.Ident)
+	if !ok || ident.Obj != nil {
+		return ""
+	}
+	return imports[ident.Name]
+}
+
+// 🎯 Сопоставление выражения с шаблоном вида "pkg.Name", "pkg.*", "*.Name" или "name"
+func matchExpr(imports map[string]string, expr ast.Expr, target string) bool {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return !strings.Contains(target, ".") && e.Name == target
+	case *ast.SelectorExpr:
+		dot := strings.LastIndex(target, ".")
+		if dot < 0 {
+			return false
+		}
+		pkg, name := target[:dot], target[dot+1:]
+		if name != "*" && e.Sel.Name != name {
+			return false
+		}
+		return pkg == "*" || packagePath(imports, e.X) == pkg
+	}
+	return false
+}
+
+// 🧩 Особые конструкции, которые не сводятся к вызову или селектору
+func nodeCount(file *ast.File, imports map[string]string, kind string) int {
+	count := 0
+
+	switch kind {
+	case "library_package":
+		// Пакет, который можно импортировать (не main)
+		if file.Name.Name != "main" && !strings.HasSuffix(file.Name.Name, "_test") {
+			count++
+		}
+		return count
+	case "module_import":
+		// Импорт по пути модуля (github.com/..., example.com/...)
+		for _, imp := range file.Imports {
+			path := strings.Trim(imp.Path.Value, "\"`")
+			first, _, _ := strings.Cut(path, "/")
+			if strings.Contains(first, ".") {
+				count++
+			}
+		}
+		return count
+	case "mutex_lock":
+		return mutexCalls(file, imports, "Lock")
+	case "mutex_rlock":
+		return mutexCalls(file, imports, "RLock")
+	case "embedded":
+		return embeddedCount(file, imports)
+	}
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.FuncDecl:
+			if kind == "benchmark" && strings.HasPrefix(node.Name.Name, "Benchmark") {
+				count++
+			}
+		case *ast.FuncType:
+			if kind == "type_params" && node.TypeParams != nil && node.TypeParams.NumFields() > 0 {
+				count++
+			}
+		case *ast.TypeSpec:
+			if kind == "type_params" && node.TypeParams != nil && node.TypeParams.NumFields() > 0 {
+				count++
+			}
+		case *ast.InterfaceType:
+			// Ограничения типов: ~int | ~float64
+			if kind == "constraint" {
+				for _, field := range node.Methods.List {
+					if len(field.Names) == 0 && isConstraintExpr(field.Type) {
+						count++
+					}
+				}
+			}
+		case *ast.SelectorExpr:
+			// for i := 0; i < b.N; i++ — b из параметра b *testing.B
+			if kind == "bench_loop" && node.Sel.Name == "N" && isTestingB(imports, node.X) {
+				count++
+			}
+		case *ast.CallExpr:
+			// fmt.Errorf("...: %w", err)
+			if kind == "error_wrap" && matchExpr(imports, node.Fun, "fmt.Errorf") && len(node.Args) > 0 {
+				if lit, ok := node.Args[0].(*ast.BasicLit); ok && strings.Contains(lit.Value, "%w") {
+					count++
+				}
+			}
+		}
+		return true
+	})
+	return count
+}
+
+// 🧪 Параметр или переменная типа *testing.B
+func isTestingB(imports map[string]string, expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+	if !ok || ident.Obj == nil {
+		return false
+	}
+	var typ ast.Expr
+	switch decl := ident.Obj.Decl.(type) {
+	case *ast.Field:
+		typ = decl.Type
+	case *ast.ValueSpec:
+		typ = decl.Type
+	}
+	star, ok := typ.(*ast.StarExpr)
+	return ok && matchExpr(imports, star.X, "testing.B")
+}
+
+// 🔐 Вызовы Lock/RLock у мьютексов из sync: у полей и переменных, объявленных
+// с типом sync.Mutex / sync.RWMutex (или указателем на него), и у значений
+// типов, встраивающих мьютекс. Lock у других типов не считается
+func mutexCalls(file *ast.File, imports map[string]string, method string) int {
+	isMutex := func(expr ast.Expr) bool {
+		switch e := expr.(type) {
+		case *ast.StarExpr:
+			expr = e.X
+		case *ast.UnaryExpr:
+			expr = e.X // &sync.Mutex{}
+		case *ast.CallExpr:
+			if ident, ok := e.Fun.(*ast.Ident); ok && ident.Name == "new" && len(e.Args) == 1 {
+				expr = e.Args[0]
+			}
+		}
+		if lit, ok := expr.(*ast.CompositeLit); ok {
+			expr = lit.Type
+		}
+		return matchExpr(imports, expr, "sync.Mutex") || matchExpr(imports, expr, "sync.RWMutex")
+	}
+
+	// Имена мьютексов и типы со встроенным мьютексом
+	mutexes := map[string]bool{}
+	embedding := map[string]bool{}
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.Field:
+			if isMutex(node.Type) {
+				for _, name := range node.Names {
+					mutexes[name.Name] = true
+				}
+			}
+		case *ast.ValueSpec:
+			for i, name := range node.Names {
+				if isMutex(node.Type) || i < len(node.Values) && isMutex(node.Values[i]) {
+					mutexes[name.Name] = true
+				}
+			}
+		case *ast.AssignStmt:
+			for i, lhs := range node.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok && i < len(node.Rhs) && isMutex(node.Rhs[i]) {
+					mutexes[ident.Name] = true
+				}
+			}
+		case *ast.TypeSpec:
+			if st, ok := node.Type.(*ast.StructType); ok {
+				for _, field := range st.Fields.List {
+					if len(field.Names) == 0 && isMutex(field.Type) {
+						embedding[node.Name.Name] = true
+					}
+				}
+			}
+		}
+		return true
+	})
+	if len(mutexes) == 0 && len(embedding) == 0 {
+		return 0
+	}
+
+	// Значения типов со встроенным мьютексом: c.Lock() у c Counter / *Counter
+	embedded := func(ident *ast.Ident) bool {
+		if ident.Obj == nil {
+			return false
+		}
+		var typ ast.Expr
+		switch decl := ident.Obj.Decl.(type) {
+		case *ast.Field:
+			typ = decl.Type
+		case *ast.ValueSpec:
+			typ = decl.Type
+		}
+		if star, ok := typ.(*ast.StarExpr); ok {
+			typ = star.X
+		}
+		name, ok := typ.(*ast.Ident)
+		return ok && embedding[name.Name]
+	}
+
+	count := 0
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != method {
+			return true
+		}
+		switch x := sel.X.(type) {
+		case *ast.Ident:
+			if mutexes[x.Name] || embedded(x) {
+				count++
+			}
+		case *ast.SelectorExpr: // c.mu.Lock()
+			if mutexes[x.Sel.Name] {
+				count++
+			}
+		}
+		return true
+	})
+	return count
+}
+
+// 🧱 Встраивание: анонимные поля структур и встроенные интерфейсы. Элементы
+// ограничений типов (comparable, constraints.Ordered, интерфейсы-ограничения
+// из type params) встраиванием не считаются
+func embeddedCount(file *ast.File, imports map[string]string) int {
+	constraints := constraintInterfaces(file)
+
+	isConstraintElem := func(expr ast.Expr) bool {
+		switch e := expr.(type) {
+		case *ast.Ident:
+			return e.Name == "comparable" || e.Name == "any" || constraints.names[e.Name]
+		case *ast.SelectorExpr:
+			path := packagePath(imports, e.X)
+			return path == "cmp" || strings.HasSuffix(path, "/constraints")
+		}
+		return isConstraintExpr(expr)
+	}
+
+	count := 0
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.InterfaceType:
+			if constraints.nodes[node] {
+				return true
+			}
+			for _, field := range node.Methods.List {
+				if len(field.Names) == 0 && !isConstraintElem(field.Type) {
+					count++
+				}
+			}
+		case *ast.StructType:
+			for _, field := range node.Fields.List {
+				if len(field.Names) == 0 {
+					count++
+				}
+			}
+		}
+		return true
+	})
+	return count
+}
+
+// 🔗 Интерфейсы-ограничения: записанные в type params, с объединениями и ~T,
+// и встраивающие другие ограничения; names — их имена в файле
+type constraintSet struct {
+	nodes map[*ast.InterfaceType]bool
+	names map[string]bool
+}
+
+func constraintInterfaces(file *ast.File) constraintSet {
+	set := constraintSet{nodes: map[*ast.InterfaceType]bool{}, names: map[string]bool{}}
+
+	markTypeParams := func(params *ast.FieldList) {
+		if params == nil {
+			return
+		}
+		for _, field := range params.List {
+			ast.Inspect(field.Type, func(n ast.Node) bool {
+				switch node := n.(type) {
+				case *ast.InterfaceType:
+					set.nodes[node] = true
+				case *ast.Ident:
+					set.names[node.Name] = true
+				}
+				return true
+			})
+		}
+	}
+
+	named := map[string]*ast.InterfaceType{}
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.FuncType:
+			markTypeParams(node.TypeParams)
+		case *ast.TypeSpec:
+			markTypeParams(node.TypeParams)
+			if iface, ok := node.Type.(*ast.InterfaceType); ok {
+				named[node.Name.Name] = iface
+			}
+		case *ast.InterfaceType:
+			for _, field := range node.Methods.List {
+				if len(field.Names) == 0 && isConstraintExpr(field.Type) {
+					set.nodes[node] = true
+				}
+			}
+		}
+		return true
+	})
+
+	// Ограничение, встроенное в именованный интерфейс, делает ограничением и его
+	for changed := true; changed; {
+		changed = false
+		for name, iface := range named {
+			if set.names[name] && !set.nodes[iface] || set.nodes[iface] && !set.names[name] {
+				set.names[name], set.nodes[iface] = true, true
+				changed = true
+			}
+			for _, field := range iface.Methods.List {
+				if ident, ok := field.Type.(*ast.Ident); ok && len(field.Names) == 0 && set.names[ident.Name] && !set.nodes[iface] {
+					set.names[name], set.nodes[iface] = true, true
+					changed = true
+				}
+			}
+		}
+	}
+	return set
+}
+
+// 🔗 Выражение ограничения типа (объединение или ~T)
+func isConstraintExpr(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.BinaryExpr:
+		return e.Op == token.OR
+	case *ast.UnaryExpr:
+		return e.Op == token.TILDE
+	}
+	return false
+}
+
+// 🧹 Удаление комментариев (и строковых литералов — в них темы не считаются)
+func RemoveComments(code string) string {
+	code = regexp.MustCompile(`//.*`).ReplaceAllString(code, "")
+	code = regexp.MustCompile(`(?s)/\*.*?\*/`).ReplaceAllString(code, "")
+	code = regexp.MustCompile(`"[^"]*"`).ReplaceAllString(code, "")
+	return code
+}
@@ -0,0 +1,269 @@
+package analyzer
+
+import (
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestRemoveComments(t *testing.T) {
+	code := "x := 1 // for \n/* switch \n */ s := \"if else\"\n"
+	got := RemoveComments(code)
+	for _, word := range []string{"for", "switch", "if", "else"} {
+		if strings.Contains(got, word) {
+			t.Errorf("%q left in %q", word, got)
+		}
+	}
+}
+
+func TestPatternCount(t *testing.T) {
+	src := `package shapes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/acme/geo"
+)
+
+type Number interface{ ~int | ~float64 }
+
+type Base struct{}
+
+type Circle struct {
+	Base
+	R float64
+}
+
+func Max[T Number](a, b T) T {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func Run(ctx context.Context, mu *sync.Mutex) error {
+	defer mu.Unlock()
+	mu.Lock()
+	go func() {}()
+	if errors.Is(ctx.Err(), context.Canceled) {
+		return fmt.Errorf("run: %w", ctx.Err())
+	}
+	_ = geo.Point{}
+	panic("unreachable")
+}
+
+func BenchmarkMax(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Max(1, 2)
+	}
+}
+`
+	file, err := parser.ParseFile(token.NewFileSet(), "shapes.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		pattern string
+		want    int
+	}{
+		{"node:type_params", 1},
+		{"node:constraint", 1},
+		{"node:embedded", 1},
+		{"node:error_wrap", 1},
+		{"node:library_package", 1},
+		{"node:module_import", 1},
+		{"node:benchmark", 1},
+		{"stmt:defer", 1},
+		{"stmt:go", 1},
+		{"call:panic", 1},
+		{"call:errors.Is", 1},
+		{"call:*.Lock", 1},
+		{"node:mutex_lock", 1},
+		{"node:bench_loop", 1},
+		{"sel:sync.Mutex", 1},
+		{"sel:context.*", 2},
+		{"call:recover", 0},
+		{"bogus:x", 0},
+	}
+	for _, tt := range tests {
+		if got := PatternCount(file, tt.pattern); got != tt.want {
+			t.Errorf("PatternCount(%q) = %d, want %d", tt.pattern, got, tt.want)
+		}
+	}
+}
+
+// 🚫 Похожие, но чужие конструкции: Lock не у мьютекса, переменная с
+// именем пакета, элементы ограничений в интерфейсах
+func TestPatternCountLookalikes(t *testing.T) {
+	src := `package vault
+
+import (
+	stdctx "context"
+	"sync"
+)
+
+type Door struct{}
+
+func (d *Door) Lock()  {}
+func (d *Door) RLock() {}
+
+type Key interface{ comparable }
+
+type Ordered interface{ ~int | ~string }
+
+type Sortable interface{ Ordered }
+
+type Cache[K Key, V any] struct {
+	mu    sync.RWMutex
+	items map[K]V
+}
+
+type Counter struct {
+	sync.Mutex
+	n int
+}
+
+type Shape interface{ Area() float64 }
+
+type Solid interface {
+	Shape
+	Volume() float64
+}
+
+func Use(d *Door, c *Counter, cache *Cache[string, int], b struct{ N int }) {
+	context := map[string]int{}
+	context.Lock = 1
+	_ = context.Background
+	d.Lock()
+	d.RLock()
+	c.Lock()
+	cache.mu.RLock()
+	_ = stdctx.Background()
+	_ = b.N
+	var lock sync.Mutex
+	lock.Lock()
+	mu := &sync.Mutex{}
+	mu.Lock()
+}
+
+func Min[T interface{ comparable }](a T) T { return a }
+`
+	file, err := parser.ParseFile(token.NewFileSet(), "vault.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		pattern string
+		want    int
+	}{
+		// Только stdctx.Background(): переменная context — не пакет
+		{"sel:context.*", 1},
+		// Door.Lock не считается; Counter встраивает мьютекс, lock и mu — мьютексы
+		{"node:mutex_lock", 3},
+		{"node:mutex_rlock", 1},
+		// Counter: sync.Mutex; Solid: Shape. Key, Sortable и type params — ограничения
+		{"node:embedded", 2},
+		{"node:constraint", 1},
+		// b — не *testing.B
+		{"node:bench_loop", 0},
+		{"sel:sync.Mutex", 3},
+	}
+	for _, tt := range tests {
+		if got := PatternCount(file, tt.pattern); got != tt.want {
+			t.Errorf("PatternCount(%q) = %d, want %d", tt.pattern, got, tt.want)
+		}
+	}
+}
+
+func TestAnalyzeSource(t *testing.T) {
+	topics := DefaultSyllabus()
+	src := []byte(`package main
+
+// if else for
+func main() {
+	var a int = 1
+	if a > 0 {
+		a++
+	} else {
+		a--
+	}
+	println("switch")
+}
+`)
+	report := AnalyzeSource(topics, "main.go", src)
+	if report.ParseErr != nil {
+		t.Fatal(report.ParseErr)
+	}
+
+	conditions, _ := Find(topics, "conditions")
+	if conditions.Found != 2 || conditions.Counts["if "] != 1 || conditions.Counts["else"] != 1 {
+		t.Errorf("conditions = %d %v", conditions.Found, conditions.Counts)
+	}
+	if loops, _ := Find(topics, "loops"); loops.Found != 0 {
+		t.Errorf("loops from comment: %d", loops.Found)
+	}
+	if sw, _ := Find(topics, "switch"); sw.Found != 0 {
+		t.Errorf("switch from string literal: %d", sw.Found)
+	}
+
+	broken := AnalyzeSource(DefaultSyllabus(), "broken.go", []byte("package main\nfunc {"))
+	if broken.ParseErr == nil {
+		t.Error("broken.go: want ParseErr")
+	}
+}
+
+func TestAnalyzeFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"basics/types.go": {Data: []byte("package main\nvar a int\nvar b string\n")},
+		"basics/loops.go": {Data: []byte("package main\nfunc f() { for i := 0; i < 3; i++ {} }\n")},
+		"vendor/x/x.go":   {Data: []byte("package x\nvar c int\n")},
+		"README.md":       {Data: []byte("var var var")},
+	}
+
+	files := FindGoFiles(fsys, ".", func(path string) bool {
+		return strings.HasSuffix(path, ".go") && !strings.HasPrefix(path, "vendor/")
+	})
+	if len(files) != 2 {
+		t.Fatalf("files = %v", files)
+	}
+
+	topics := DefaultSyllabus()
+	reports := Analyze(topics, fsys, files)
+	if len(reports) != 2 {
+		t.Fatalf("reports = %+v", reports)
+	}
+
+	variables, _ := Find(topics, "variables")
+	if variables.Found != 2 {
+		t.Errorf("variables = %d, want 2", variables.Found)
+	}
+
+	// Повторный анализ начинается с нуля
+	Analyze(topics, fsys, files)
+	if variables, _ := Find(topics, "variables"); variables.Found != 2 {
+		t.Errorf("second run: variables = %d, want 2", variables.Found)
+	}
+}
+
+func TestCompletedIDs(t *testing.T) {
+	topics := DefaultSyllabus()
+	topics[0].Found = topics[0].MinExamples
+	topics[1].Found = topics[1].MinExamples
+	topics[1].ReviewPending = true
+
+	ids := CompletedIDs(topics)
+	if len(ids) != 1 || ids[0] != "types" {
+		t.Errorf("CompletedIDs = %v", ids)
+	}
+	if MaxLevel(topics) != 12 {
+		t.Errorf("MaxLevel = %d", MaxLevel(topics))
+	}
+}
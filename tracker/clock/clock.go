@@ -0,0 +1,30 @@
+// Package clock — источник текущего времени. Трекер берёт «сегодня»
+// только из Clock, поэтому streak, штрафы и сезоны можно проверить на
+// любой дате.
+package clock
+
+import "time"
+
+// ⏰ Текущее время
+type Clock interface {
+	Now() time.Time
+}
+
+// 🕰️ Системные часы
+type System struct{}
+
+func (System) Now() time.Time { return time.Now() }
+
+// 📌 Часы, которые всегда показывают одно и то же время
+type Fixed time.Time
+
+func (f Fixed) Now() time.Time { return time.Time(f) }
+
+// 📅 Фиксированные часы на полдень даты YYYY-MM-DD (UTC); неверная дата — паника
+func At(date string) Fixed {
+	t, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		panic(err)
+	}
+	return Fixed(t.Add(12 * time.Hour))
+}
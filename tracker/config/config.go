@@ -0,0 +1,368 @@
+// Package config собирает настройки трекера: значения по умолчанию,
+// файл tracker.json и переменные окружения (у них приоритет выше).
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yourusername/go-learning-tracker/tracker/achievements"
+	"github.com/yourusername/go-learning-tracker/tracker/analyzer"
+	"github.com/yourusername/go-learning-tracker/tracker/progress"
+)
+
+// 📄 Файл настроек по умолчанию (в корне репозитория)
+const File = "tracker.json"
+
+// ⚙️ Настройки трекера
+// json — ключ в tracker.json, env — переменная окружения, которая его
+// переопределяет. Секреты (токены, webhook) сюда не входят — только env.
+type Config struct {
+	// Правила XP
+	PenaltyPerDay     int `json:"penalty_per_day" env:"TRACKER_PENALTY_PER_DAY"`
+	StreakBonusPerDay int `json:"streak_bonus_per_day" env:"TRACKER_STREAK_BONUS_PER_DAY"`
+
+	// Стартовая лига: пороги уровня и XP для Silver, Gold и Diamond
+	LeagueLevels []int `json:"league_levels" env:"TRACKER_LEAGUE_LEVELS"`
+	LeagueXP     []int `json:"league_xp" env:"TRACKER_LEAGUE_XP"`
+
+	// Сезоны: доля лиги (1/N) на повышение и понижение, пороги сезонного XP
+	// за месяц без соперников и награды за итог сезона
+	SeasonMoveShare      int `json:"season_move_share" env:"TRACKER_SEASON_MOVE_SHARE"`
+	SeasonPromoteXP      int `json:"season_promote_xp" env:"TRACKER_SEASON_PROMOTE_XP"`
+	SeasonKeepXP         int `json:"season_keep_xp" env:"TRACKER_SEASON_KEEP_XP"`
+	SeasonRewardPromoted int `json:"season_reward_promoted" env:"TRACKER_SEASON_REWARD_PROMOTED"`
+	SeasonRewardStayed   int `json:"season_reward_stayed" env:"TRACKER_SEASON_REWARD_STAYED"`
+	SeasonRewardChampion int `json:"season_reward_champion" env:"TRACKER_SEASON_REWARD_CHAMPION"`
+
+	// Заморозки streak в месяц
+	FreezesPerMonth int `json:"freezes_per_month" env:"TRACKER_FREEZES_PER_MONTH"`
+
+	// Защита от читеров: минимум коммитов для достижения уровня (уровень → коммиты)
+	LevelCommits map[int]int `json:"level_commits" env:"TRACKER_LEVEL_COMMITS"`
+
+	// Отчёт: streak показывается с N дней, в фокусе — до N тем
+	StreakShowFrom int `json:"streak_show_from" env:"TRACKER_STREAK_SHOW_FROM"`
+	FocusTopics    int `json:"focus_topics" env:"TRACKER_FOCUS_TOPICS"`
+
+	// Режимы
+	Season            string `json:"season" env:"TRACKER_SEASON"`
+	LevelModel        string `json:"level_model" env:"LEVEL_MODEL"`
+	LevelCurve        string `json:"level_curve" env:"LEVEL_CURVE"`
+	Kata              bool   `json:"kata" env:"TRACKER_KATA"`
+	Review            bool   `json:"review" env:"TRACKER_REVIEW"`
+	Language          string `json:"language" env:"TRACKER_LANG"`
+	BadgeMode         string `json:"badge_mode" env:"BADGE_MODE"`
+	HeatmapMetric     string `json:"heatmap_metric" env:"HEATMAP_METRIC"`
+	TelegramParseMode string `json:"telegram_parse_mode" env:"TELEGRAM_PARSE_MODE"`
+
+	// Integrity: проверка новых коммитов на накрутку (порог 0 — проверка выключена)
+	Integrity              bool `json:"integrity" env:"TRACKER_INTEGRITY"`
+	IntegrityBackdateHours int  `json:"integrity_backdate_hours" env:"TRACKER_INTEGRITY_BACKDATE_HOURS"`
+	IntegrityMaxFiles      int  `json:"integrity_max_files" env:"TRACKER_INTEGRITY_MAX_FILES"`
+	IntegrityMaxTopics     int  `json:"integrity_max_topics" env:"TRACKER_INTEGRITY_MAX_TOPICS"`
+
+	// Журнал (stderr) и машиночитаемый итог запуска (пусто — не писать)
+	LogLevel   string `json:"log_level" env:"TRACKER_LOG_LEVEL"`
+	LogFormat  string `json:"log_format" env:"TRACKER_LOG_FORMAT"`
+	ResultFile string `json:"result_file" env:"TRACKER_RESULT_FILE"`
+}
+
+// 📦 Значения по умолчанию (каждый вызов — новая копия)
+func Default() Config {
+	return Config{
+		PenaltyPerDay:     30,
+		StreakBonusPerDay: 20,
+		LeagueLevels:      []int{4, 7, 10},
+		LeagueXP:          []int{2000, 4000, 6500},
+
+		SeasonMoveShare:      progress.SeasonMoveShare,
+		SeasonPromoteXP:      progress.SeasonPromoteXP,
+		SeasonKeepXP:         progress.SeasonKeepXP,
+		SeasonRewardPromoted: progress.SeasonRewardPromoted,
+		SeasonRewardStayed:   progress.SeasonRewardStayed,
+		SeasonRewardChampion: progress.SeasonRewardChampion,
+		FreezesPerMonth:      progress.FreezesPerMonth,
+
+		LevelCommits:      map[int]int{3: 10, 5: 25, 7: 50, 10: 75, 12: 100},
+		StreakShowFrom:    3,
+		FocusTopics:       5,
+		Season:            "month",
+		LevelModel:        "strict",
+		LevelCurve:        "exp:500:1.5",
+		BadgeMode:         "local",
+		HeatmapMetric:     "lines",
+		TelegramParseMode: "MarkdownV2",
+
+		IntegrityBackdateHours: 24,
+		IntegrityMaxFiles:      10,
+		IntegrityMaxTopics:     3,
+
+		LogLevel:  "warn",
+		LogFormat: "text",
+	}
+}
+
+// ⚖️ Правила XP, лиг и достижений из настроек
+func (c Config) Rules() progress.Rules {
+	return progress.Rules{
+		PenaltyPerDay:     c.PenaltyPerDay,
+		StreakBonusPerDay: c.StreakBonusPerDay,
+		LeagueLevels:      c.LeagueLevels,
+		LeagueXP:          c.LeagueXP,
+		Achievements:      achievements.Rules{LevelCommits: c.LevelCommits},
+
+		SeasonMoveShare:      c.SeasonMoveShare,
+		SeasonPromoteXP:      c.SeasonPromoteXP,
+		SeasonKeepXP:         c.SeasonKeepXP,
+		SeasonRewardPromoted: c.SeasonRewardPromoted,
+		SeasonRewardStayed:   c.SeasonRewardStayed,
+		SeasonRewardChampion: c.SeasonRewardChampion,
+
+		FreezesPerMonth: c.FreezesPerMonth,
+	}
+}
+
+// 🏷️ Откуда взялось значение
+const (
+	SourceDefault = "default"
+	SourceEnv     = "env"
+)
+
+// 📋 Итоговое значение настройки (для config print)
+type Field struct {
+	Key    string // Ключ в tracker.json
+	Env    string
+	Value  string // В том же виде, что и в переменной окружения
+	Source string // default, имя файла или env
+}
+
+// 📥 Настройки: умолчания, затем файл name из fsys (если есть), затем env
+// getenv — обычно os.Getenv; пустая переменная не переопределяет значение
+func Load(fsys fs.FS, name string, getenv func(string) string) (Config, []Field, error) {
+	cfg := Default()
+	sources := map[string]string{}
+
+	data, err := fs.ReadFile(fsys, name)
+	switch {
+	case err == nil:
+		keys, err := decodeFile(data, &cfg)
+		if err != nil {
+			return Default(), nil, fmt.Errorf("%s: %w", name, err)
+		}
+		for _, key := range keys {
+			sources[key] = name
+		}
+	case !errors.Is(err, fs.ErrNotExist):
+		return Default(), nil, err
+	}
+
+	var errs []error
+	value := reflect.ValueOf(&cfg).Elem()
+	for _, field := range reflect.VisibleFields(value.Type()) {
+		env := getenv(field.Tag.Get("env"))
+		if env == "" {
+			continue
+		}
+		if err := parseValue(value.FieldByIndex(field.Index), env); err != nil {
+			errs = append(errs, fmt.Errorf("%s=%q: %v", field.Tag.Get("env"), env, err))
+			continue
+		}
+		sources[jsonKey(field)] = SourceEnv
+	}
+	if err := errors.Join(errs...); err != nil {
+		return Default(), nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return Default(), nil, err
+	}
+	return cfg, cfg.fields(sources), nil
+}
+
+// 🧾 Разбор tracker.json: незнакомые ключи — ошибка (скорее всего опечатка)
+func decodeFile(data []byte, cfg *Config) ([]string, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	known := map[string]bool{}
+	for _, field := range reflect.VisibleFields(reflect.TypeOf(*cfg)) {
+		known[jsonKey(field)] = true
+	}
+	var keys []string
+	for key := range raw {
+		if !known[key] {
+			return nil, fmt.Errorf("unknown key %q", key)
+		}
+		keys = append(keys, key)
+	}
+
+	// Карты json дополняет, а не заменяет — level_commits из файла целиком
+	if _, ok := raw["level_commits"]; ok {
+		cfg.LevelCommits = nil
+	}
+	return keys, json.Unmarshal(data, cfg)
+}
+
+func jsonKey(field reflect.StructField) string {
+	key, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	return key
+}
+
+// 🔤 Значение из строки окружения: 30 · true · 4,7,10 · 3:10,5:25
+func parseValue(field reflect.Value, text string) error {
+	switch field.Kind() {
+	case reflect.Int:
+		n, err := strconv.Atoi(text)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(n))
+	case reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.String:
+		field.SetString(text)
+	case reflect.Slice:
+		var list []int
+		for _, item := range strings.Split(text, ",") {
+			n, err := strconv.Atoi(strings.TrimSpace(item))
+			if err != nil {
+				return err
+			}
+			list = append(list, n)
+		}
+		field.Set(reflect.ValueOf(list))
+	case reflect.Map:
+		pairs := map[int]int{}
+		for _, item := range strings.Split(text, ",") {
+			key, value, ok := strings.Cut(strings.TrimSpace(item), ":")
+			k, errKey := strconv.Atoi(key)
+			v, errValue := strconv.Atoi(value)
+			if !ok || errKey != nil || errValue != nil {
+				return fmt.Errorf("%q: want <level>:<commits>", item)
+			}
+			pairs[k] = v
+		}
+		field.Set(reflect.ValueOf(pairs))
+	}
+	return nil
+}
+
+// 🔡 Значение в виде для окружения
+func formatValue(field reflect.Value) string {
+	switch field.Kind() {
+	case reflect.Slice:
+		var items []string
+		for _, n := range field.Interface().([]int) {
+			items = append(items, strconv.Itoa(n))
+		}
+		return strings.Join(items, ",")
+	case reflect.Map:
+		pairs := field.Interface().(map[int]int)
+		keys := make([]int, 0, len(pairs))
+		for key := range pairs {
+			keys = append(keys, key)
+		}
+		sort.Ints(keys)
+		var items []string
+		for _, key := range keys {
+			items = append(items, fmt.Sprintf("%d:%d", key, pairs[key]))
+		}
+		return strings.Join(items, ",")
+	}
+	return fmt.Sprint(field.Interface())
+}
+
+// 📋 Все настройки по порядку объявления
+func (c Config) fields(sources map[string]string) []Field {
+	var fields []Field
+	value := reflect.ValueOf(c)
+	for _, field := range reflect.VisibleFields(value.Type()) {
+		source := sources[jsonKey(field)]
+		if source == "" {
+			source = SourceDefault
+		}
+		fields = append(fields, Field{
+			Key:    jsonKey(field),
+			Env:    field.Tag.Get("env"),
+			Value:  formatValue(value.FieldByIndex(field.Index)),
+			Source: source,
+		})
+	}
+	return fields
+}
+
+// ✅ Проверка значений: все ошибки сразу
+func (c Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...interface{}) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	check(c.PenaltyPerDay >= 0, "penalty_per_day: %d < 0", c.PenaltyPerDay)
+	check(c.StreakBonusPerDay >= 0, "streak_bonus_per_day: %d < 0", c.StreakBonusPerDay)
+	check(c.StreakShowFrom >= 0, "streak_show_from: %d < 0", c.StreakShowFrom)
+	check(c.FocusTopics >= 1, "focus_topics: %d < 1", c.FocusTopics)
+	check(ascending(c.LeagueLevels, 3), "league_levels: want 3 ascending values, got %v", c.LeagueLevels)
+	check(ascending(c.LeagueXP, 3), "league_xp: want 3 ascending values, got %v", c.LeagueXP)
+	check(c.SeasonMoveShare >= 2, "season_move_share: %d < 2", c.SeasonMoveShare)
+	check(c.SeasonKeepXP >= 0, "season_keep_xp: %d < 0", c.SeasonKeepXP)
+	check(c.SeasonPromoteXP > c.SeasonKeepXP, "season_promote_xp: %d <= season_keep_xp %d", c.SeasonPromoteXP, c.SeasonKeepXP)
+	check(c.SeasonRewardPromoted >= 0, "season_reward_promoted: %d < 0", c.SeasonRewardPromoted)
+	check(c.SeasonRewardStayed >= 0, "season_reward_stayed: %d < 0", c.SeasonRewardStayed)
+	check(c.SeasonRewardChampion >= 0, "season_reward_champion: %d < 0", c.SeasonRewardChampion)
+	check(c.FreezesPerMonth >= 0, "freezes_per_month: %d < 0", c.FreezesPerMonth)
+	for level, commits := range c.LevelCommits {
+		check(commits >= 0, "level_commits: level %d: %d < 0", level, commits)
+	}
+	check(c.IntegrityBackdateHours >= 0, "integrity_backdate_hours: %d < 0", c.IntegrityBackdateHours)
+	check(c.IntegrityMaxFiles >= 0, "integrity_max_files: %d < 0", c.IntegrityMaxFiles)
+	check(c.IntegrityMaxTopics >= 0, "integrity_max_topics: %d < 0", c.IntegrityMaxTopics)
+	check(oneOf(c.Season, "month", "quarter"), "season: %q (month or quarter)", c.Season)
+	check(oneOf(c.LevelModel, "strict", "xp", "highest"), "level_model: %q (strict, xp or highest)", c.LevelModel)
+	if _, err := progress.ParseCurve(c.LevelCurve, analyzer.MaxLevel(analyzer.DefaultSyllabus())); err != nil {
+		errs = append(errs, fmt.Errorf("level_curve: %q: %v", c.LevelCurve, err))
+	}
+	check(oneOf(c.BadgeMode, "local", "shields"), "badge_mode: %q (local or shields)", c.BadgeMode)
+	check(oneOf(c.HeatmapMetric, "lines", "xp"), "heatmap_metric: %q (lines or xp)", c.HeatmapMetric)
+	check(oneOf(strings.ToLower(c.TelegramParseMode), "markdownv2", "html"), "telegram_parse_mode: %q (MarkdownV2 or HTML)", c.TelegramParseMode)
+	check(oneOf(c.LogLevel, "debug", "info", "warn", "error"), "log_level: %q (debug, info, warn or error)", c.LogLevel)
+	check(oneOf(c.LogFormat, "text", "json"), "log_format: %q (text or json)", c.LogFormat)
+
+	return errors.Join(errs...)
+}
+
+func ascending(values []int, n int) bool {
+	if len(values) != n {
+		return false
+	}
+	for i := 1; i < len(values); i++ {
+		if values[i] <= values[i-1] {
+			return false
+		}
+	}
+	return true
+}
+
+func oneOf(value string, allowed ...string) bool {
+	for _, a := range allowed {
+		if value == a {
+			return true
+		}
+	}
+	return false
+}
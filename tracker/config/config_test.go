@@ -0,0 +1,94 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/yourusername/go-learning-tracker/tracker/progress"
+)
+
+// 🔗 Умолчания совпадают с правилами пакетов (трекер как библиотека без настроек)
+func TestDefaultMatchesPackages(t *testing.T) {
+	cfg := Default()
+	if rules := cfg.Rules(); !reflect.DeepEqual(rules, progress.DefaultRules()) {
+		t.Errorf("rules: %+v, packages %+v", rules, progress.DefaultRules())
+	}
+	if cfg.LevelCurve != progress.DefaultCurve || cfg.Season != progress.SeasonMonth || cfg.LevelModel != progress.ModelStrict {
+		t.Errorf("modes: %+v", cfg)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+// 🥞 Файл поверх умолчаний, окружение поверх файла
+func TestLoadLayers(t *testing.T) {
+	fsys := fstest.MapFS{File: {Data: []byte(`{"penalty_per_day": 10, "level_commits": {"3": 5}, "season": "quarter"}`)}}
+	env := map[string]string{"TRACKER_PENALTY_PER_DAY": "15", "TRACKER_KATA": "1", "TRACKER_LEAGUE_XP": "100, 200, 300"}
+
+	cfg, fields, err := Load(fsys, File, func(key string) string { return env[key] })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.PenaltyPerDay != 15 || !cfg.Kata || cfg.Season != "quarter" || cfg.StreakBonusPerDay != 20 {
+		t.Errorf("cfg = %+v", cfg)
+	}
+	if !reflect.DeepEqual(cfg.LevelCommits, map[int]int{3: 5}) || !reflect.DeepEqual(cfg.LeagueXP, []int{100, 200, 300}) {
+		t.Errorf("level_commits = %v, league_xp = %v", cfg.LevelCommits, cfg.LeagueXP)
+	}
+
+	want := map[string]Field{
+		"penalty_per_day":      {Key: "penalty_per_day", Env: "TRACKER_PENALTY_PER_DAY", Value: "15", Source: SourceEnv},
+		"streak_bonus_per_day": {Key: "streak_bonus_per_day", Env: "TRACKER_STREAK_BONUS_PER_DAY", Value: "20", Source: SourceDefault},
+		"level_commits":        {Key: "level_commits", Env: "TRACKER_LEVEL_COMMITS", Value: "3:5", Source: File},
+		"league_xp":            {Key: "league_xp", Env: "TRACKER_LEAGUE_XP", Value: "100,200,300", Source: SourceEnv},
+		"kata":                 {Key: "kata", Env: "TRACKER_KATA", Value: "true", Source: SourceEnv},
+	}
+	for _, field := range fields {
+		if w, ok := want[field.Key]; ok && field != w {
+			t.Errorf("field = %+v, want %+v", field, w)
+		}
+	}
+	if len(fields) != reflect.TypeOf(Config{}).NumField() {
+		t.Errorf("%d fields", len(fields))
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		env  map[string]string
+		want string
+	}{
+		{"unknown key", `{"penalty": 10}`, nil, `unknown key "penalty"`},
+		{"bad json", `{`, nil, "tracker.json"},
+		{"bad env", ``, map[string]string{"TRACKER_PENALTY_PER_DAY": "many"}, "TRACKER_PENALTY_PER_DAY"},
+		{"bad level commits", ``, map[string]string{"TRACKER_LEVEL_COMMITS": "3=10"}, "<level>:<commits>"},
+		{"negative", `{"streak_bonus_per_day": -1}`, nil, "streak_bonus_per_day"},
+		{"leagues", `{"league_xp": [4000, 2000, 6500]}`, nil, "league_xp"},
+		{"mode", ``, map[string]string{"LEVEL_MODEL": "fast"}, "level_model"},
+		{"curve", ``, map[string]string{"LEVEL_CURVE": "exp:0"}, "level_curve"},
+		{"curve table", `{"level_curve": "table:100,200"}`, nil, "level_curve"},
+		{"season thresholds", `{"season_promote_xp": 300}`, nil, "season_promote_xp"},
+		{"season share", ``, map[string]string{"TRACKER_SEASON_MOVE_SHARE": "0"}, "season_move_share"},
+		{"freezes", `{"freezes_per_month": -1}`, nil, "freezes_per_month"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsys := fstest.MapFS{}
+			if tt.file != "" {
+				fsys[File] = &fstest.MapFile{Data: []byte(tt.file)}
+			}
+			cfg, _, err := Load(fsys, File, func(key string) string { return tt.env[key] })
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("err = %v, want %q", err, tt.want)
+			}
+			if !reflect.DeepEqual(cfg, Default()) {
+				t.Errorf("invalid config must fall back to defaults")
+			}
+		})
+	}
+}
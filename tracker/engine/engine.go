@@ -0,0 +1,178 @@
+// Package engine — один запуск трекера для ученика: сезон, штрафы, streak,
+// темы на проверке у ментора, XP за новые темы и достижения, история.
+// Всё нужное приходит в Input и Rules, итог возвращается в Result: пакет
+// ничего не печатает, не читает файлов и не хранит состояния.
+package engine
+
+import (
+	"time"
+
+	"github.com/yourusername/go-learning-tracker/tracker/achievements"
+	"github.com/yourusername/go-learning-tracker/tracker/analyzer"
+	"github.com/yourusername/go-learning-tracker/tracker/integrity"
+	"github.com/yourusername/go-learning-tracker/tracker/progress"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// ⚖️ Правила запуска
+type Rules struct {
+	XP          progress.Rules
+	Progression progress.Progression
+	Season      string // progress.SeasonMonth или progress.SeasonQuarter
+	Review      bool   // Review mode: каждая новая тема ждёт одобрения ментора
+	Integrity   bool   // Темы из подозрительных коммитов ждут одобрения ментора
+}
+
+// 📥 Данные запуска
+type Input struct {
+	Stats    store.UserStats  // Статистика до запуска
+	Topics   []analyzer.Topic // Программа с результатами анализа (и упражнений)
+	Previous []string         // ID тем, засчитанных до этого запуска
+	Now      time.Time
+	Commits  int              // Новых коммитов с прошлого запуска
+	Flags    []integrity.Flag // Подозрения в новых коммитах (Topics заполняет Run)
+	Approved map[string]bool  // Темы, одобренные ментором
+
+	// Участники завершившегося сезона (nil — без соперников)
+	Standings func(season string) []progress.Standing
+	// Тексты новых достижений (nil — без текстов)
+	Localize func(achievements.Achievement) achievements.Achievement
+}
+
+// 📤 Итог запуска
+type Result struct {
+	Stats           store.UserStats      // Новая статистика (для сохранения)
+	Topics          []analyzer.Topic     // Программа с отметками ReviewPending
+	XP              progress.XPBreakdown // NewTopics не заполнены — названия даёт вызывающий
+	NewTopics       []string             // ID новых тем
+	NewAchievements []achievements.Achievement
+	SeasonEnd       *store.SeasonResult // Итог завершённого сезона (nil — сезон не менялся)
+	Flags           []integrity.Flag    // Подозрения запуска с придержанными темами
+	Completed       int
+	Missing         int // Засчитанных раньше тем нет в коде (XP не отнимается)
+}
+
+// 🧮 Запуск: статистика и XP по коду ученика на момент in.Now
+func Run(in Input, rules Rules) Result {
+	stats := in.Stats
+	result := Result{Topics: append([]analyzer.Topic(nil), in.Topics...)}
+
+	// Новый сезон: итог прошлого, повышение или понижение лиги
+	standings := in.Standings
+	if standings == nil {
+		standings = func(string) []progress.Standing { return nil }
+	}
+	result.SeasonEnd = progress.RolloverSeason(&stats, in.Now, rules.Season, rules.XP, standings)
+	if result.SeasonEnd != nil {
+		result.XP.Season = result.SeasonEnd.Reward
+	}
+
+	result.XP.Penalty = progress.ApplyPenalties(&stats, in.Now, rules.XP)
+	stats.TotalCommits += in.Commits
+	progress.UpdateStreak(&stats, in.Now)
+
+	// Integrity: темы, изученные в запуске с подозрением, запоминаются во флагах
+	result.Flags = in.Flags
+	if rules.Integrity && len(in.Flags) > 0 {
+		ready := readyTopics(result.Topics, in.Previous)
+		result.Flags = make([]integrity.Flag, len(in.Flags))
+		for i, flag := range in.Flags {
+			flag.Topics = ready
+			result.Flags[i] = flag
+		}
+		stats.IntegrityFlags = append(stats.IntegrityFlags, result.Flags...)
+	}
+	Hold(result.Topics, in.Previous, stats, in.Approved, rules)
+
+	// XP только за НОВЫЕ темы
+	done := idSet(in.Previous)
+	for _, topic := range result.Topics {
+		if !topic.Completed() {
+			continue
+		}
+		result.Completed++
+		if !done[topic.ID] {
+			result.XP.Topics += topic.XPReward
+			result.NewTopics = append(result.NewTopics, topic.ID)
+		}
+	}
+	if result.Completed < len(in.Previous) {
+		result.Missing = len(in.Previous) - result.Completed
+	}
+
+	if stats.CurrentStreak > 0 {
+		result.XP.Streak = progress.StreakBonus(stats, rules.XP)
+	}
+
+	stats.TotalXP += result.XP.Gained()
+	stats.Level = rules.Progression.Level(stats, result.Topics)
+	stats.CompletedTopics = result.Completed
+
+	found := achievements.Check(achievements.Progress{
+		TotalCommits:  stats.TotalCommits,
+		CurrentStreak: stats.CurrentStreak,
+		Level:         stats.Level,
+		Topics:        result.Topics,
+	}, stats.Achievements, rules.XP.Achievements)
+	for _, ach := range found {
+		if in.Localize != nil {
+			ach = in.Localize(ach)
+		}
+		result.NewAchievements = append(result.NewAchievements, ach)
+		stats.Achievements = append(stats.Achievements, ach)
+		stats.TotalXP += ach.XPReward
+		result.XP.Achievements += ach.XPReward
+	}
+
+	// В модели xp уровень мог вырасти от XP за достижения
+	stats.Level = rules.Progression.Level(stats, result.Topics)
+
+	// Сезонный XP: всё заработанное в этом запуске, кроме награды за сезон
+	stats.SeasonXP += result.XP.Net() - result.XP.Season
+
+	progress.RecordHistory(&stats, result.XP, result.NewTopics, result.NewAchievements)
+	result.Stats = stats
+	return result
+}
+
+// 🔒 Отметки ReviewPending: новая тема (не из previous) с достаточным
+// числом примеров и пройденными упражнениями ждёт ментора — в review mode
+// любая, с integrity — придержанная подозрениями из stats. Одобренные
+// ментором засчитываются
+func Hold(topics []analyzer.Topic, previous []string, stats store.UserStats, approved map[string]bool, rules Rules) {
+	done := idSet(previous)
+	held := map[string]bool{}
+	if rules.Integrity {
+		held = integrity.HeldTopics(stats.IntegrityFlags)
+	}
+
+	for i := range topics {
+		topic := &topics[i]
+		topic.ReviewPending = false
+		if done[topic.ID] || !topic.Completed() || approved[topic.ID] {
+			continue
+		}
+		topic.ReviewPending = rules.Review || held[topic.ID]
+	}
+}
+
+// 📚 Новые темы, готовые к зачёту (без учёта ментора)
+func readyTopics(topics []analyzer.Topic, previous []string) []string {
+	done := idSet(previous)
+	var ready []string
+	for _, topic := range topics {
+		topic.ReviewPending = false
+		if !done[topic.ID] && topic.Completed() {
+			ready = append(ready, topic.ID)
+		}
+	}
+	return ready
+}
+
+func idSet(ids []string) map[string]bool {
+	set := map[string]bool{}
+	for _, id := range ids {
+		set[id] = true
+	}
+	return set
+}
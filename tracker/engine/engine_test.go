@@ -0,0 +1,123 @@
+package engine
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/yourusername/go-learning-tracker/tracker/achievements"
+	"github.com/yourusername/go-learning-tracker/tracker/analyzer"
+	"github.com/yourusername/go-learning-tracker/tracker/clock"
+	"github.com/yourusername/go-learning-tracker/tracker/integrity"
+	"github.com/yourusername/go-learning-tracker/tracker/progress"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 📚 Программа с изученными темами (остальные не начаты)
+func topicsWith(ids ...string) []analyzer.Topic {
+	topics := analyzer.DefaultSyllabus()
+	for i := range topics {
+		for _, id := range ids {
+			if topics[i].ID == id {
+				topics[i].Found = topics[i].MinExamples
+			}
+		}
+	}
+	return topics
+}
+
+func testRules() Rules {
+	thresholds, _ := progress.ParseCurve(progress.DefaultCurve, analyzer.MaxLevel(analyzer.DefaultSyllabus()))
+	return Rules{
+		XP:          progress.DefaultRules(),
+		Progression: progress.Progression{Model: progress.ModelStrict, Thresholds: thresholds},
+		Season:      progress.SeasonMonth,
+	}
+}
+
+// 🧮 Первый запуск и запуск после пропуска: XP по источникам
+func TestRun(t *testing.T) {
+	rules := testRules()
+	first := Run(Input{
+		Stats:   store.NewStats("alice"),
+		Topics:  topicsWith("types", "variables"),
+		Now:     clock.At("2026-01-30").Now(),
+		Commits: 1,
+		Localize: func(ach achievements.Achievement) achievements.Achievement {
+			ach.Name = strings.ToUpper(ach.ID)
+			return ach
+		},
+	}, rules)
+
+	// 2 темы по 50 XP + streak 20 XP + «Первый коммит» 100 XP
+	if first.Stats.TotalXP != 220 || first.XP.Topics != 100 || first.XP.Streak != 20 || first.XP.Achievements != 100 {
+		t.Fatalf("first run: XP %+v, total %d", first.XP, first.Stats.TotalXP)
+	}
+	if !reflect.DeepEqual(first.NewTopics, []string{"types", "variables"}) || first.Completed != 2 {
+		t.Errorf("new topics %v, completed %d", first.NewTopics, first.Completed)
+	}
+	if len(first.NewAchievements) != 1 || first.Stats.Achievements[0].Name != "FIRST_COMMIT" {
+		t.Errorf("achievements: %+v", first.Stats.Achievements)
+	}
+	if first.Stats.Season != "2026-01" || len(first.Stats.History) != 1 || first.Stats.SeasonXP != 220 {
+		t.Errorf("stats: %+v", first.Stats)
+	}
+
+	// Два дня пропуска, новый месяц; «Переменные» удалены из кода
+	second := Run(Input{
+		Stats:    first.Stats,
+		Topics:   topicsWith("types"),
+		Previous: []string{"types", "variables"},
+		Now:      clock.At("2026-02-02").Now(),
+		Commits:  3,
+	}, rules)
+	if second.XP.Penalty != 60 || second.XP.Topics != 0 || second.Missing != 1 || second.SeasonEnd == nil {
+		t.Errorf("second run: XP %+v, missing %d, season %+v", second.XP, second.Missing, second.SeasonEnd)
+	}
+	if second.Stats.TotalCommits != 4 || second.Stats.CurrentStreak != 1 {
+		t.Errorf("second run: %+v", second.Stats)
+	}
+}
+
+// 🔒 Review mode и integrity: новые темы ждут ментора, одобренные засчитываются
+func TestRunHold(t *testing.T) {
+	rules := testRules()
+	rules.Review = true
+	in := Input{
+		Stats:    store.NewStats("alice"),
+		Topics:   topicsWith("types", "variables"),
+		Now:      clock.At("2026-03-01").Now(),
+		Approved: map[string]bool{"variables": true},
+	}
+	result := Run(in, rules)
+	if result.XP.Topics != 50 || !reflect.DeepEqual(result.NewTopics, []string{"variables"}) {
+		t.Errorf("review: XP %+v, new %v", result.XP, result.NewTopics)
+	}
+	if topic, _ := analyzer.Find(result.Topics, "types"); !topic.ReviewPending {
+		t.Errorf("types not pending: %+v", topic)
+	}
+	if in.Topics[0].ReviewPending {
+		t.Errorf("input topics changed")
+	}
+
+	rules.Review, rules.Integrity = false, true
+	in.Flags = []integrity.Flag{{Kind: integrity.BulkFiles, Commit: "c3", Value: 12}}
+	in.Approved = nil
+	result = Run(in, rules)
+	if result.XP.Topics != 0 || len(result.Stats.IntegrityFlags) != 1 {
+		t.Fatalf("integrity: XP %+v, flags %+v", result.XP, result.Stats.IntegrityFlags)
+	}
+	if topics := result.Flags[0].Topics; !reflect.DeepEqual(topics, []string{"types", "variables"}) {
+		t.Errorf("held topics: %v", topics)
+	}
+	if in.Flags[0].Topics != nil {
+		t.Errorf("input flags changed")
+	}
+
+	// Придержанные темы ждут и в следующих запусках — без новых подозрений
+	topics := topicsWith("types", "variables")
+	Hold(topics, nil, result.Stats, map[string]bool{"types": true}, rules)
+	if topics[0].ReviewPending || !topics[1].ReviewPending {
+		t.Errorf("Hold: types %v, variables %v", topics[0].ReviewPending, topics[1].ReviewPending)
+	}
+}
@@ -0,0 +1,77 @@
+// Package integrity ищет в новых коммитах признаки накрутки прогресса:
+// коммиты задним числом, массовое добавление файлов, коммиты, закрывающие
+// сразу много тем, и переписанную историю (force push).
+package integrity
+
+import "time"
+
+// 🚩 Виды подозрений
+const (
+	Backdated  = "backdated"   // Дата автора намного раньше даты коммита
+	BulkFiles  = "bulk_files"  // Коммит добавил много учебных файлов сразу
+	ManyTopics = "many_topics" // Коммит закрыл много тем сразу
+	Rewritten  = "rewritten"   // Коммит прошлого запуска пропал из истории
+)
+
+// 🧾 Новый коммит с прошлого запуска
+type Commit struct {
+	Hash       string
+	AuthorDate time.Time // Её можно задать любой (git commit --date)
+	CommitDate time.Time
+	AddedFiles int      // Новых учебных файлов
+	Topics     []string // ID тем, изученных в этом коммите (у родителя — нет)
+}
+
+// 📏 Пороги проверки (0 — проверка выключена)
+type Rules struct {
+	BackdateHours int // Насколько дата автора может отставать от даты коммита
+	MaxFiles      int // Новых учебных файлов в одном коммите
+	MaxTopics     int // Тем, изученных одним коммитом
+}
+
+// 🚩 Подозрение (хранится в stats.json)
+type Flag struct {
+	Kind   string
+	Commit string   // Короткий хэш (для rewritten — коммит прошлого запуска)
+	Date   string   // День обнаружения
+	Value  int      // Часы, файлы или темы — сколько намерено
+	Topics []string `json:",omitempty"` // Темы, придержанные до решения ментора
+}
+
+// 🔍 Подозрения по новым коммитам (Date и Topics заполняет вызывающий)
+func Check(commits []Commit, rules Rules) []Flag {
+	var flags []Flag
+	for _, commit := range commits {
+		hash := Short(commit.Hash)
+
+		if hours := int(commit.CommitDate.Sub(commit.AuthorDate).Hours()); rules.BackdateHours > 0 && hours > rules.BackdateHours {
+			flags = append(flags, Flag{Kind: Backdated, Commit: hash, Value: hours})
+		}
+		if rules.MaxFiles > 0 && commit.AddedFiles > rules.MaxFiles {
+			flags = append(flags, Flag{Kind: BulkFiles, Commit: hash, Value: commit.AddedFiles})
+		}
+		if rules.MaxTopics > 0 && len(commit.Topics) > rules.MaxTopics {
+			flags = append(flags, Flag{Kind: ManyTopics, Commit: hash, Value: len(commit.Topics)})
+		}
+	}
+	return flags
+}
+
+// 🔒 Темы, придержанные хотя бы одним подозрением
+func HeldTopics(flags []Flag) map[string]bool {
+	held := map[string]bool{}
+	for _, flag := range flags {
+		for _, id := range flag.Topics {
+			held[id] = true
+		}
+	}
+	return held
+}
+
+// ✂️ Короткий хэш коммита
+func Short(hash string) string {
+	if len(hash) > 7 {
+		return hash[:7]
+	}
+	return hash
+}
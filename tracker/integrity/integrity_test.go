@@ -0,0 +1,47 @@
+package integrity
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCheck(t *testing.T) {
+	day := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	rules := Rules{BackdateHours: 24, MaxFiles: 10, MaxTopics: 3}
+
+	commits := []Commit{
+		// Обычный коммит и rebase: автор отстаёт меньше порога
+		{Hash: "aaaaaaa111", AuthorDate: day.Add(-20 * time.Hour), CommitDate: day, AddedFiles: 2, Topics: []string{"loops"}},
+		// git commit --date: неделя назад
+		{Hash: "bbbbbbb222", AuthorDate: day.AddDate(0, 0, -7), CommitDate: day},
+		// Весь курс одним коммитом
+		{Hash: "ccccccc333", AuthorDate: day, CommitDate: day, AddedFiles: 14, Topics: []string{"types", "variables", "conditions", "loops"}},
+	}
+
+	want := []Flag{
+		{Kind: Backdated, Commit: "bbbbbbb", Value: 168},
+		{Kind: BulkFiles, Commit: "ccccccc", Value: 14},
+		{Kind: ManyTopics, Commit: "ccccccc", Value: 4},
+	}
+	if got := Check(commits, rules); !reflect.DeepEqual(got, want) {
+		t.Errorf("Check = %+v\nwant    %+v", got, want)
+	}
+
+	// Нулевые пороги выключают проверки
+	if got := Check(commits, Rules{}); len(got) != 0 {
+		t.Errorf("no rules: %+v", got)
+	}
+}
+
+func TestHeldTopics(t *testing.T) {
+	flags := []Flag{
+		{Kind: Rewritten, Commit: "aaaaaaa"},
+		{Kind: BulkFiles, Commit: "bbbbbbb", Topics: []string{"types", "loops"}},
+		{Kind: ManyTopics, Commit: "bbbbbbb", Topics: []string{"loops"}},
+	}
+	want := map[string]bool{"types": true, "loops": true}
+	if got := HeldTopics(flags); !reflect.DeepEqual(got, want) {
+		t.Errorf("HeldTopics = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,145 @@
+// Package leaderboard — клиент общего leaderboard (webhook Google Apps
+// Script): POST отправляет статистику, GET возвращает таблицу по XP.
+package leaderboard
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// ⏱️ Сколько ждать webhook: медленный сервис не должен вешать трекер
+const Timeout = 10 * time.Second
+
+var client = &http.Client{Timeout: Timeout}
+
+// 🌍 LEADERBOARD ENTRY (для отправки на сервер)
+type Entry struct {
+	Username        string `json:"username"`
+	Level           int    `json:"level"`
+	TotalXP         int    `json:"total_xp"`
+	League          string `json:"league"`
+	Season          string `json:"season"`
+	SeasonXP        int    `json:"season_xp"`
+	CompletedTopics int    `json:"completed_topics"`
+	CurrentStreak   int    `json:"current_streak"`
+	LongestStreak   int    `json:"longest_streak"`
+	TotalCommits    int    `json:"total_commits"`
+	LastUpdate      string `json:"last_update"`
+
+	// Итог прошлого сезона: по нему ранжируют те, кто подводит его позже
+	PrevSeason   string `json:"prev_season,omitempty"`
+	PrevSeasonXP int    `json:"prev_season_xp,omitempty"`
+	PrevLeague   string `json:"prev_league,omitempty"`
+}
+
+// 📤 Запись для отправки из статистики ученика
+func NewEntry(stats store.UserStats, now time.Time) Entry {
+	entry := Entry{
+		Username:        stats.Username,
+		Level:           stats.Level,
+		TotalXP:         stats.TotalXP,
+		League:          stats.League,
+		Season:          stats.Season,
+		SeasonXP:        stats.SeasonXP,
+		CompletedTopics: stats.CompletedTopics,
+		CurrentStreak:   stats.CurrentStreak,
+		LongestStreak:   stats.LongestStreak,
+		TotalCommits:    stats.TotalCommits,
+		LastUpdate:      now.Format("2006-01-02 15:04:05"),
+	}
+	if n := len(stats.Seasons); n > 0 {
+		prev := stats.Seasons[n-1]
+		entry.PrevSeason, entry.PrevSeasonXP, entry.PrevLeague = prev.ID, prev.XP, prev.League
+	}
+	return entry
+}
+
+// 🏅 Строка leaderboard (ответ GET на webhook, отсортирован по XP)
+type Row struct {
+	Username        string `json:"username"`
+	XP              int    `json:"xp"`
+	Level           int    `json:"level"`
+	League          string `json:"league"`
+	CompletedTopics int    `json:"completed_topics"`
+	CurrentStreak   int    `json:"current_streak"`
+	LongestStreak   int    `json:"longest_streak"`
+	TotalCommits    int    `json:"total_commits"`
+	Season          string `json:"season"`
+	SeasonXP        int    `json:"season_xp"`
+	PrevSeason      string `json:"prev_season"`
+	PrevSeasonXP    int    `json:"prev_season_xp"`
+	PrevLeague      string `json:"prev_league"`
+}
+
+// 🏆 Позиция в общем leaderboard (Position 0 — нет данных)
+type Position struct {
+	Position int
+	Total    int
+	XPToNext int
+}
+
+// 👆 Место, до которого считается XPToNext
+func (p Position) Ahead() int {
+	return p.Position - 1
+}
+
+// 🌍 Отправка статистики; возвращает HTTP-статус и тело ответа
+func Send(webhookURL string, entry Entry) (int, string, error) {
+	jsonData, err := json.Marshal(entry)
+	if err != nil {
+		return 0, "", err
+	}
+
+	resp, err := client.Post(webhookURL, "application/json", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, string(body), nil
+}
+
+// 📥 Загрузка leaderboard
+func Fetch(webhookURL string) ([]Row, error) {
+	resp, err := client.Get(webhookURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+
+	var result struct {
+		Status      string `json:"status"`
+		Leaderboard []Row  `json:"leaderboard"`
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Leaderboard, nil
+}
+
+// 📊 Позиция пользователя в таблице и XP до места выше
+func PositionOf(rows []Row, username string) Position {
+	position := Position{Total: len(rows)}
+
+	for i, user := range rows {
+		if user.Username == username {
+			position.Position = i + 1
+			if i > 0 {
+				position.XPToNext = rows[i-1].XP - user.XP
+			}
+			break
+		}
+	}
+
+	return position
+}
@@ -0,0 +1,113 @@
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// 🐙 Адрес GitHub REST API по умолчанию (в Actions — GITHUB_API_URL)
+const GitHubAPIURL = "https://api.github.com"
+
+// 💬 Комментарий к pull request
+type PRCommenter interface {
+	CommentPR(number int, body string) error
+}
+
+// 🐙 Клиент GitHub REST API: один комментарий трекера на PR —
+// повторный запуск обновляет его, а не добавляет новый
+type GitHub struct {
+	APIURL string // Пусто — GitHubAPIURL
+	Token  string
+	Repo   string // owner/name
+	Marker string // Скрытая метка в начале комментария, по ней находится прошлый
+}
+
+// 📝 Комментарий в issue-ленте PR (body — готовый Markdown)
+type ghComment struct {
+	ID   int64  `json:"id,omitempty"`
+	Body string `json:"body"`
+}
+
+// 📨 Ошибка GitHub API
+type ghError struct {
+	Message string `json:"message"`
+}
+
+func (g GitHub) url(path string, args ...interface{}) string {
+	api := strings.TrimRight(g.APIURL, "/")
+	if api == "" {
+		api = GitHubAPIURL
+	}
+	return api + fmt.Sprintf(path, args...)
+}
+
+// 💬 Создать или обновить комментарий трекера
+func (g GitHub) CommentPR(number int, body string) error {
+	if g.Marker != "" {
+		body = g.Marker + "\n" + body
+	}
+
+	id, err := g.findComment(number)
+	if err != nil {
+		return err
+	}
+	if id != 0 {
+		return g.do(http.MethodPatch, g.url("/repos/%s/issues/comments/%d", g.Repo, id), ghComment{Body: body}, nil)
+	}
+	return g.do(http.MethodPost, g.url("/repos/%s/issues/%d/comments", g.Repo, number), ghComment{Body: body}, nil)
+}
+
+// 🔎 Прошлый комментарий трекера (0 — нет или метка не задана)
+func (g GitHub) findComment(number int) (int64, error) {
+	if g.Marker == "" {
+		return 0, nil
+	}
+
+	var comments []ghComment
+	if err := g.do(http.MethodGet, g.url("/repos/%s/issues/%d/comments?per_page=100", g.Repo, number), nil, &comments); err != nil {
+		return 0, err
+	}
+	for _, comment := range comments {
+		if strings.HasPrefix(comment.Body, g.Marker) {
+			return comment.ID, nil
+		}
+	}
+	return 0, nil
+}
+
+// 🔌 Запрос к API; ответ не 2xx — *APIError с сообщением GitHub
+func (g GitHub) do(method, url string, body, result interface{}) error {
+	var payload bytes.Buffer
+	if body != nil {
+		json.NewEncoder(&payload).Encode(body)
+	}
+
+	req, err := http.NewRequest(method, url, &payload)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Content-Type", "application/json")
+	if g.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+g.Token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var apiErr ghError
+		json.NewDecoder(resp.Body).Decode(&apiErr)
+		return &APIError{Status: resp.StatusCode, Description: apiErr.Message}
+	}
+	if result != nil {
+		return json.NewDecoder(resp.Body).Decode(result)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package notify
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"testing"
+)
+
+// 🐙 Фейковый GitHub: комментарии одного PR в памяти
+func fakeGitHub(t *testing.T, comments []ghComment) (*httptest.Server, *[]ghComment) {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/alice/go/issues/7/comments", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"message":"Bad credentials"}`))
+			return
+		}
+		if r.Method == http.MethodPost {
+			var comment ghComment
+			json.NewDecoder(r.Body).Decode(&comment)
+			comment.ID = int64(len(comments) + 1)
+			comments = append(comments, comment)
+			w.WriteHeader(http.StatusCreated)
+		}
+		json.NewEncoder(w).Encode(comments)
+	})
+	mux.HandleFunc("/repos/alice/go/issues/comments/", func(w http.ResponseWriter, r *http.Request) {
+		id, _ := strconv.ParseInt(path.Base(r.URL.Path), 10, 64)
+		var comment ghComment
+		json.NewDecoder(r.Body).Decode(&comment)
+		for i := range comments {
+			if comments[i].ID == id && r.Method == http.MethodPatch {
+				comments[i].Body = comment.Body
+				json.NewEncoder(w).Encode(comments[i])
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server, &comments
+}
+
+func TestCommentPRUpsert(t *testing.T) {
+	server, comments := fakeGitHub(t, []ghComment{{ID: 1, Body: "👍"}})
+	gh := GitHub{APIURL: server.URL, Token: "secret", Repo: "alice/go", Marker: "<!-- tracker -->"}
+
+	if err := gh.CommentPR(7, "+50 XP"); err != nil {
+		t.Fatal(err)
+	}
+	if err := gh.CommentPR(7, "+200 XP"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []ghComment{{ID: 1, Body: "👍"}, {ID: 2, Body: "<!-- tracker -->\n+200 XP"}}
+	if len(*comments) != len(want) {
+		t.Fatalf("comments = %+v, want %+v", *comments, want)
+	}
+	for i := range want {
+		if (*comments)[i] != want[i] {
+			t.Errorf("comment %d = %+v, want %+v", i, (*comments)[i], want[i])
+		}
+	}
+}
+
+func TestCommentPRError(t *testing.T) {
+	server, _ := fakeGitHub(t, nil)
+	gh := GitHub{APIURL: server.URL, Token: "wrong", Repo: "alice/go", Marker: "<!-- tracker -->"}
+
+	err := gh.CommentPR(7, "+50 XP")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Status != http.StatusUnauthorized || apiErr.Description != "Bad credentials" {
+		t.Errorf("err = %v, want 401 Bad credentials", err)
+	}
+}
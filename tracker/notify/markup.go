@@ -0,0 +1,103 @@
+// Package notify доставляет отчёты: разметка под получателя, Telegram и комментарии GitHub.
+package notify
+
+import "strings"
+
+// ✍️ Разметка в отчёте: шаблоны вызывают Bold/Italic, а вместо символов
+// разметки в текст попадают маркеры из Private Use Area. Настоящий
+// синтаксис (и экранирование всего остального текста) подставляет
+// Apply под конкретного получателя.
+const (
+	markBoldOpen    = "\uE000"
+	markBoldClose   = "\uE001"
+	markItalicOpen  = "\uE002"
+	markItalicClose = "\uE003"
+)
+
+func Bold(text string) string   { return markBoldOpen + text + markBoldClose }
+func Italic(text string) string { return markItalicOpen + text + markItalicClose }
+
+// 🎨 Получатель разметки
+type Markup int
+
+const (
+	Plain      Markup = iota // Без разметки — запасной вариант для Telegram
+	Console                  // Консоль: *жирный* без экранирования
+	Markdown                 // Markdown-файлы (GitHub)
+	MarkdownV2               // Telegram parse_mode=MarkdownV2
+	HTML                     // Telegram parse_mode=HTML
+)
+
+// 🎨 Как превратить маркеры в разметку
+type markupStyle struct {
+	Escape      func(string) string
+	BoldOpen    string
+	BoldClose   string
+	ItalicOpen  string
+	ItalicClose string
+}
+
+func (m Markup) style() markupStyle {
+	switch m {
+	case Console:
+		return markupStyle{Escape: noEscape, BoldOpen: "*", BoldClose: "*", ItalicOpen: "_", ItalicClose: "_"}
+	case Markdown:
+		return markupStyle{Escape: noEscape, BoldOpen: "**", BoldClose: "**", ItalicOpen: "_", ItalicClose: "_"}
+	case MarkdownV2:
+		return markupStyle{Escape: escapeMarkdownV2, BoldOpen: "*", BoldClose: "*", ItalicOpen: "_", ItalicClose: "_"}
+	case HTML:
+		return markupStyle{Escape: escapeTelegramHTML, BoldOpen: "<b>", BoldClose: "</b>", ItalicOpen: "<i>", ItalicClose: "</i>"}
+	}
+	return markupStyle{Escape: noEscape}
+}
+
+func noEscape(text string) string { return text }
+
+// 🔣 Символы, которые MarkdownV2 требует экранировать вне разметки
+func escapeMarkdownV2(text string) string {
+	var pairs []string
+	for _, ch := range `\_*[]()~` + "`" + `>#+-=|{}.!` {
+		pairs = append(pairs, string(ch), `\`+string(ch))
+	}
+	return strings.NewReplacer(pairs...).Replace(text)
+}
+
+// 🔣 Telegram HTML понимает только эти три сущности
+func escapeTelegramHTML(text string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+}
+
+// 🖌️ Экранирование текста и замена маркеров на разметку получателя
+func Apply(text string, m Markup) string {
+	style := m.style()
+
+	var result strings.Builder
+	var plain strings.Builder
+
+	flush := func() {
+		result.WriteString(style.Escape(plain.String()))
+		plain.Reset()
+	}
+
+	for _, r := range text {
+		var markup string
+		switch string(r) {
+		case markBoldOpen:
+			markup = style.BoldOpen
+		case markBoldClose:
+			markup = style.BoldClose
+		case markItalicOpen:
+			markup = style.ItalicOpen
+		case markItalicClose:
+			markup = style.ItalicClose
+		default:
+			plain.WriteRune(r)
+			continue
+		}
+		flush()
+		result.WriteString(markup)
+	}
+	flush()
+
+	return result.String()
+}
@@ -0,0 +1,39 @@
+package notify
+
+import "testing"
+
+func TestEscapeMarkdownV2(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"обычный текст", "обычный текст"},
+		{"_*[]()~`>#+-=|{}.!", `\_\*\[\]\(\)\~\` + "`" + `\>\#\+\-\=\|\{\}\.\!`},
+		{`C:\go`, `C:\\go`},
+		{"Level 3 (+150 XP).", `Level 3 \(\+150 XP\)\.`},
+		{"🔥 7-day streak!", `🔥 7\-day streak\!`},
+	}
+	for _, tt := range tests {
+		if got := escapeMarkdownV2(tt.in); got != tt.want {
+			t.Errorf("escapeMarkdownV2(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	text := Bold("Level 2.") + " " + Italic("a<b>") + " +50 XP"
+	tests := []struct {
+		markup Markup
+		want   string
+	}{
+		{Plain, "Level 2. a<b> +50 XP"},
+		{Console, "*Level 2.* _a<b>_ +50 XP"},
+		{Markdown, "**Level 2.** _a<b>_ +50 XP"},
+		{MarkdownV2, `*Level 2\.* _a<b\>_ \+50 XP`},
+		{HTML, "<b>Level 2.</b> <i>a&lt;b&gt;</i> +50 XP"},
+	}
+	for _, tt := range tests {
+		if got := Apply(text, tt.markup); got != tt.want {
+			t.Errorf("Apply(%d) = %q, want %q", tt.markup, got, tt.want)
+		}
+	}
+}
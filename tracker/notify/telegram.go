@@ -0,0 +1,218 @@
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+// 📏 Лимит Telegram на длину одного сообщения (в UTF-16 символах)
+const TelegramMessageLimit = 4096
+
+// 🌐 Адрес Bot API по умолчанию
+const TelegramAPIURL = "https://api.telegram.org"
+
+// ⏱️ Сколько ждать ответа на sendMessage
+const TelegramTimeout = 15 * time.Second
+
+// 📤 Сообщение для sendMessage
+type TGMessage struct {
+	ChatID    string `json:"chat_id"`
+	Text      string `json:"text"`
+	ParseMode string `json:"parse_mode,omitempty"`
+}
+
+// 📨 Ответ Bot API
+type TGResponse struct {
+	OK          bool   `json:"ok"`
+	ErrorCode   int    `json:"error_code"`
+	Description string `json:"description"`
+}
+
+// ❌ API (Bot API или GitHub) ответил ошибкой
+type APIError struct {
+	Status      int
+	Description string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("status %d: %s", e.Status, e.Description)
+}
+
+// 🤖 Клиент Bot API
+type Telegram struct {
+	APIURL string // Пусто — TelegramAPIURL
+	Token  string
+	HTML   bool // parse_mode=HTML вместо MarkdownV2
+
+	// Вызывается, если Telegram не разобрал разметку и часть уходит без неё
+	OnPlainFallback func(description string)
+}
+
+// 🔗 Адрес метода Bot API
+func (t Telegram) MethodURL(method string) string {
+	api := strings.TrimRight(t.APIURL, "/")
+	if api == "" {
+		api = TelegramAPIURL
+	}
+	return fmt.Sprintf("%s/bot%s/%s", api, t.Token, method)
+}
+
+// 🙈 Ошибка запроса без токена: адрес метода в *url.Error содержит bot<TOKEN>,
+// а ошибки попадают в консоль, логи и result_file
+func (t Telegram) Redact(err error) error {
+	if err == nil || t.Token == "" {
+		return err
+	}
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return &url.Error{Op: urlErr.Op, URL: strings.ReplaceAll(urlErr.URL, t.Token, "***"), Err: urlErr.Err}
+	}
+	if strings.Contains(err.Error(), t.Token) {
+		return errors.New(strings.ReplaceAll(err.Error(), t.Token, "***"))
+	}
+	return err
+}
+
+// 🎨 parse_mode и разметка для него
+func (t Telegram) parseMode() (string, Markup) {
+	if t.HTML {
+		return "HTML", HTML
+	}
+	return "MarkdownV2", MarkdownV2
+}
+
+// 📬 Доставка текста с маркерами разметки в чат; возвращает число сообщений
+// Длинный текст уходит несколькими сообщениями
+func (t Telegram) Deliver(chatID, text string) (int, error) {
+	mode, markup := t.parseMode()
+	chunks := SplitMessage(text, markup, TelegramMessageLimit)
+
+	for i, chunk := range chunks {
+		if err := t.sendChunk(chatID, chunk, mode, markup); err != nil {
+			return i, err
+		}
+	}
+	return len(chunks), nil
+}
+
+// 📨 Одна часть отчёта; если Telegram не разобрал разметку — повтор без неё
+func (t Telegram) sendChunk(chatID, chunk, mode string, markup Markup) error {
+	status, resp, err := t.post(TGMessage{
+		ChatID:    chatID,
+		Text:      Apply(chunk, markup),
+		ParseMode: mode,
+	})
+	if err != nil {
+		return err
+	}
+
+	if status == http.StatusBadRequest && strings.Contains(resp.Description, "can't parse entities") {
+		if t.OnPlainFallback != nil {
+			t.OnPlainFallback(resp.Description)
+		}
+		status, resp, err = t.post(TGMessage{
+			ChatID: chatID,
+			Text:   Apply(chunk, Plain),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if status != http.StatusOK {
+		return &APIError{Status: status, Description: resp.Description}
+	}
+	return nil
+}
+
+// 🔌 POST sendMessage
+func (t Telegram) post(msg TGMessage) (int, TGResponse, error) {
+	var result TGResponse
+
+	jsonBody, _ := json.Marshal(msg)
+	client := &http.Client{Timeout: TelegramTimeout}
+	resp, err := client.Post(t.MethodURL("sendMessage"), "application/json", bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return 0, result, t.Redact(err)
+	}
+	defer resp.Body.Close()
+
+	json.NewDecoder(resp.Body).Decode(&result)
+	return resp.StatusCode, result, nil
+}
+
+// ✂️ Разбивка отчёта на части, каждая из которых после разметки влезает в limit
+// Режем по строкам; строку длиннее лимита — по символам (без разметки)
+func SplitMessage(text string, markup Markup, limit int) []string {
+	var chunks []string
+	var current string
+
+	for _, line := range strings.SplitAfter(text, "\n") {
+		if line == "" {
+			continue
+		}
+
+		if markupLength(current+line, markup) <= limit {
+			current += line
+			continue
+		}
+
+		if current != "" {
+			chunks = append(chunks, current)
+			current = ""
+		}
+
+		if markupLength(line, markup) <= limit {
+			current = line
+			continue
+		}
+
+		pieces := splitLongLine(Apply(line, Plain), markup, limit)
+		chunks = append(chunks, pieces[:len(pieces)-1]...)
+		current = pieces[len(pieces)-1]
+	}
+
+	if current != "" {
+		chunks = append(chunks, current)
+	}
+	return chunks
+}
+
+// ✂️ Строка без разметки, порезанная по символам
+func splitLongLine(line string, markup Markup, limit int) []string {
+	escape := markup.style().Escape
+
+	var pieces []string
+	var piece strings.Builder
+	size := 0
+
+	for _, r := range line {
+		width := utf16Length(escape(string(r)))
+		if size+width > limit && piece.Len() > 0 {
+			pieces = append(pieces, piece.String())
+			piece.Reset()
+			size = 0
+		}
+		piece.WriteRune(r)
+		size += width
+	}
+	pieces = append(pieces, piece.String())
+
+	return pieces
+}
+
+// 📏 Длина текста после разметки
+func markupLength(text string, markup Markup) int {
+	return utf16Length(Apply(text, markup))
+}
+
+func utf16Length(text string) int {
+	return len(utf16.Encode([]rune(text)))
+}
@@ -0,0 +1,168 @@
+package notify
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestSplitMessage(t *testing.T) {
+	boldLines := strings.Repeat(Bold("Level 2: Functions")+" — 3/5 (+100 XP)\n", 300)
+
+	tests := []struct {
+		name   string
+		text   string
+		markup Markup
+		sizes  []int // Длина частей после разметки, в UTF-16
+	}{
+		{"короткий", "🎮 Go Learning Tracker\n", MarkdownV2, []int{23}},
+		{"ровно лимит", strings.Repeat("a", 4096), MarkdownV2, []int{4096}},
+		{"лимит + 1", strings.Repeat("a", 4097), MarkdownV2, []int{4096, 1}},
+		// Эмодзи — два UTF-16 символа: суррогатная пара не разрезается
+		{"суррогатные пары", strings.Repeat("😀", 2049), MarkdownV2, []int{4096, 2}},
+		{"нечётный лимит", "a" + strings.Repeat("😀", 2048), MarkdownV2, []int{4095, 2}},
+		// Экранирование удваивает длину — режем по длине после разметки
+		{"экранирование", strings.Repeat(".", 3000), MarkdownV2, []int{4096, 1904}},
+		{"без экранирования в HTML", strings.Repeat(".", 3000), HTML, []int{3000}},
+		// Строки целиком: жирный не разрывается между частями
+		{"строки с разметкой", boldLines, MarkdownV2, []int{4080, 4080, 3840}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chunks := SplitMessage(tt.text, tt.markup, TelegramMessageLimit)
+
+			var sizes []int
+			for _, chunk := range chunks {
+				sizes = append(sizes, markupLength(chunk, tt.markup))
+				if !utf8.ValidString(chunk) {
+					t.Errorf("chunk cuts a rune")
+				}
+				if strings.Count(chunk, markBoldOpen) != strings.Count(chunk, markBoldClose) {
+					t.Errorf("chunk cuts bold text")
+				}
+			}
+			if !equalInts(sizes, tt.sizes) {
+				t.Errorf("sizes = %v, want %v", sizes, tt.sizes)
+			}
+			if joined := strings.Join(chunks, ""); Apply(joined, Plain) != Apply(tt.text, Plain) {
+				t.Errorf("text lost when splitting")
+			}
+		})
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// 🤖 Фейковый Bot API: отвечает на sendMessage по очереди из replies
+func fakeTelegram(t *testing.T, replies ...string) (*httptest.Server, *[]TGMessage) {
+	t.Helper()
+	var sent []TGMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/botsecret/sendMessage" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var msg TGMessage
+		json.NewDecoder(r.Body).Decode(&msg)
+		sent = append(sent, msg)
+
+		reply := `200 {"ok":true}`
+		if len(replies) > 0 {
+			reply, replies = replies[0], replies[1:]
+		}
+		status, body, _ := strings.Cut(reply, " ")
+		switch status {
+		case "400":
+			w.WriteHeader(http.StatusBadRequest)
+		case "429":
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server, &sent
+}
+
+// 🔁 Telegram не разобрал разметку — та же часть уходит простым текстом
+func TestDeliverPlainFallback(t *testing.T) {
+	server, sent := fakeTelegram(t,
+		`400 {"ok":false,"error_code":400,"description":"Bad Request: can't parse entities: Can't find end of Bold entity at byte offset 4"}`,
+		`200 {"ok":true}`,
+	)
+	var fallback string
+	tg := Telegram{APIURL: server.URL, Token: "secret", OnPlainFallback: func(description string) { fallback = description }}
+
+	parts, err := tg.Deliver("42", Bold("Level 2.")+" +50 XP")
+	if err != nil || parts != 1 {
+		t.Fatalf("Deliver = %d, %v", parts, err)
+	}
+	if len(*sent) != 2 {
+		t.Fatalf("sent %d messages, want 2", len(*sent))
+	}
+	if first := (*sent)[0]; first.ParseMode != "MarkdownV2" || first.Text != `*Level 2\.* \+50 XP` {
+		t.Errorf("first try: %+v", first)
+	}
+	if retry := (*sent)[1]; retry.ParseMode != "" || retry.Text != "Level 2. +50 XP" || retry.ChatID != "42" {
+		t.Errorf("plain retry: %+v", retry)
+	}
+	if !strings.Contains(fallback, "can't parse entities") {
+		t.Errorf("OnPlainFallback got %q", fallback)
+	}
+}
+
+// ❌ Другие ошибки не повторяются без разметки
+func TestDeliverAPIError(t *testing.T) {
+	tests := []struct {
+		reply  string
+		status int
+	}{
+		{`400 {"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`, http.StatusBadRequest},
+		{`429 {"ok":false,"error_code":429,"description":"Too Many Requests: retry after 5"}`, http.StatusTooManyRequests},
+	}
+	for _, tt := range tests {
+		server, sent := fakeTelegram(t, tt.reply)
+		tg := Telegram{APIURL: server.URL, Token: "secret", HTML: true}
+
+		_, err := tg.Deliver("42", "report")
+		apiErr, ok := err.(*APIError)
+		if !ok || apiErr.Status != tt.status || !strings.Contains(tt.reply, apiErr.Description) {
+			t.Errorf("%d: err = %v", tt.status, err)
+		}
+		if len(*sent) != 1 || (*sent)[0].ParseMode != "HTML" {
+			t.Errorf("%d: sent %+v", tt.status, *sent)
+		}
+	}
+}
+
+// 🙈 Сетевая ошибка без токена: она уходит в логи и result_file
+func TestDeliverRedactsToken(t *testing.T) {
+	server, _ := fakeTelegram(t)
+	server.Close()
+	tg := Telegram{APIURL: server.URL, Token: "123:SECRET"}
+
+	_, err := tg.Deliver("42", "report")
+	if err == nil || strings.Contains(err.Error(), "SECRET") {
+		t.Fatalf("err = %v", err)
+	}
+	if !strings.Contains(err.Error(), "/bot***/sendMessage") {
+		t.Errorf("err = %v, want redacted method URL", err)
+	}
+	if errors.Unwrap(err) == nil {
+		t.Errorf("cause lost: %v", err)
+	}
+}
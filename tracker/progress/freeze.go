@@ -0,0 +1,92 @@
+package progress
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 🧊 Заморозка streak: заранее отмеченный день без коммитов не сбрасывает
+// серию и не штрафуется. Не больше limit дней в месяц (Rules.FreezesPerMonth).
+const FreezesPerMonth = 2 // По умолчанию
+
+// ❌ Почему день нельзя заморозить
+var (
+	ErrFreezePast    = errors.New("freeze: day is not in the future")
+	ErrFreezeAlready = errors.New("freeze: day is already frozen")
+	ErrFreezeLimit   = errors.New("freeze: monthly limit reached")
+)
+
+// 🧊 Заморозить день (только будущий)
+func FreezeDay(stats *store.UserStats, day, now time.Time, limit int) error {
+	date := day.Format("2006-01-02")
+	if date <= now.Format("2006-01-02") {
+		return ErrFreezePast
+	}
+
+	month := day.Format("2006-01")
+	used := 0
+	for _, frozen := range stats.FrozenDays {
+		if frozen == date {
+			return ErrFreezeAlready
+		}
+		if frozenMonth(frozen) == month {
+			used++
+		}
+	}
+	if used >= limit {
+		return ErrFreezeLimit
+	}
+
+	// Старые (и битые) заморозки больше не нужны ни для штрафов, ни для лимита
+	cutoff := now.AddDate(0, -2, 0).Format("2006-01-02")
+	var kept []string
+	for _, frozen := range stats.FrozenDays {
+		if frozenMonth(frozen) != "" && frozen >= cutoff {
+			kept = append(kept, frozen)
+		}
+	}
+
+	stats.FrozenDays = append(kept, date)
+	sort.Strings(stats.FrozenDays)
+	return nil
+}
+
+// 🧊 Сколько замороженных дней среди days-1 дней после from
+func FrozenDaysBetween(stats store.UserStats, from time.Time, days int) int {
+	frozen := map[string]bool{}
+	for _, date := range stats.FrozenDays {
+		frozen[date] = true
+	}
+
+	count := 0
+	for i := 1; i < days; i++ {
+		if frozen[from.AddDate(0, 0, i).Format("2006-01-02")] {
+			count++
+		}
+	}
+	return count
+}
+
+// 🧊 Заморозки в месяце дня day
+func FreezesLeft(stats store.UserStats, day time.Time, limit int) int {
+	month := day.Format("2006-01")
+	left := limit
+	for _, frozen := range stats.FrozenDays {
+		if frozenMonth(frozen) == month {
+			left--
+		}
+	}
+	return left
+}
+
+// 📅 Месяц замороженного дня ("" — запись в stats.json не дата)
+func frozenMonth(frozen string) string {
+	day, err := time.Parse("2006-01-02", frozen)
+	if err != nil {
+		return ""
+	}
+	return day.Format("2006-01")
+}
@@ -0,0 +1,79 @@
+package progress
+
+import (
+	"testing"
+
+	"github.com/yourusername/go-learning-tracker/tracker/clock"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+func TestFreezeDay(t *testing.T) {
+	now := clock.At("2026-01-30").Now()
+	stats := store.UserStats{FrozenDays: []string{"2025-10-01"}}
+
+	if err := FreezeDay(&stats, now, now, FreezesPerMonth); err != ErrFreezePast {
+		t.Errorf("today: err = %v, want ErrFreezePast", err)
+	}
+	if err := FreezeDay(&stats, clock.At("2026-01-31").Now(), now, FreezesPerMonth); err != nil {
+		t.Fatalf("2026-01-31: %v", err)
+	}
+	if err := FreezeDay(&stats, clock.At("2026-01-31").Now(), now, FreezesPerMonth); err != ErrFreezeAlready {
+		t.Errorf("again: err = %v, want ErrFreezeAlready", err)
+	}
+
+	// Лимит считается по месяцу замороженного дня, а не по сегодняшнему
+	for _, day := range []string{"2026-02-02", "2026-02-01"} {
+		if err := FreezeDay(&stats, clock.At(day).Now(), now, FreezesPerMonth); err != nil {
+			t.Fatalf("%s: %v", day, err)
+		}
+	}
+	if err := FreezeDay(&stats, clock.At("2026-02-03").Now(), now, FreezesPerMonth); err != ErrFreezeLimit {
+		t.Errorf("third in February: err = %v, want ErrFreezeLimit", err)
+	}
+
+	want := []string{"2026-01-31", "2026-02-01", "2026-02-02"}
+	if len(stats.FrozenDays) != len(want) {
+		t.Fatalf("FrozenDays = %v, want %v", stats.FrozenDays, want)
+	}
+	for i := range want {
+		if stats.FrozenDays[i] != want[i] {
+			t.Fatalf("FrozenDays = %v, want %v", stats.FrozenDays, want)
+		}
+	}
+
+	if left := FreezesLeft(stats, clock.At("2026-02-15").Now(), FreezesPerMonth); left != 0 {
+		t.Errorf("FreezesLeft(February) = %d, want 0", left)
+	}
+	if left := FreezesLeft(stats, clock.At("2026-01-15").Now(), FreezesPerMonth); left != 1 {
+		t.Errorf("FreezesLeft(January) = %d, want 1", left)
+	}
+}
+
+func TestFrozenDaysBetween(t *testing.T) {
+	stats := store.UserStats{FrozenDays: []string{"2026-01-30", "2026-02-01", "2026-02-05"}}
+	from := clock.At("2026-01-29").Now()
+
+	// Дни после from и до сегодняшнего (не включая): 30, 31, 1, 2
+	if got := FrozenDaysBetween(stats, from, 5); got != 2 {
+		t.Errorf("FrozenDaysBetween = %d, want 2", got)
+	}
+	if got := FrozenDaysBetween(stats, from, 1); got != 0 {
+		t.Errorf("FrozenDaysBetween(next day) = %d, want 0", got)
+	}
+}
+
+// 🧨 Битые записи в stats.json (ручная правка) не роняют трекер и не считаются
+func TestFreezeCorruptEntries(t *testing.T) {
+	now := clock.At("2026-01-30").Now()
+	stats := store.UserStats{FrozenDays: []string{"", "2026", "2026-02-xx"}}
+
+	if left := FreezesLeft(stats, clock.At("2026-02-15").Now(), FreezesPerMonth); left != FreezesPerMonth {
+		t.Errorf("FreezesLeft = %d, want %d", left, FreezesPerMonth)
+	}
+	if err := FreezeDay(&stats, clock.At("2026-02-01").Now(), now, FreezesPerMonth); err != nil {
+		t.Fatalf("FreezeDay: %v", err)
+	}
+	if len(stats.FrozenDays) != 1 || stats.FrozenDays[0] != "2026-02-01" {
+		t.Errorf("FrozenDays = %q, want only 2026-02-01", stats.FrozenDays)
+	}
+}
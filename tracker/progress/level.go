@@ -0,0 +1,196 @@
+package progress
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/yourusername/go-learning-tracker/tracker/analyzer"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 📈 МОДЕЛЬ ПРОГРЕССИИ: как из тем и XP получается уровень
+//
+//	strict  — уровень N+1 засчитывается, только когда изучены все темы 1..N (по умолчанию)
+//	xp      — уровень зависит только от TotalXP (кривая Thresholds)
+//	highest — старое поведение: самый высокий уровень с любой изученной темой
+const (
+	ModelStrict  = "strict"
+	ModelXP      = "xp"
+	ModelHighest = "highest"
+)
+
+// 📐 Кривая по умолчанию: Level 2 — 500 XP, каждый следующий уровень в 1.5 раза дороже
+const DefaultCurve = "exp:500:1.5"
+
+// ⏭️ Сколько осталось до следующего уровня (Next 0 — уровень максимальный)
+type LevelProgress struct {
+	Model        string
+	Next         int
+	XPToNext     int     // XP до следующего уровня (в strict — XP за нужные темы)
+	TopicsToNext int     // Темы, которые осталось изучить (strict и highest)
+	Percent      float64 // Пройдено от текущего уровня до следующего
+}
+
+// 📈 Модель прогрессии и порог XP каждого уровня: [0] — Level 1 (всегда 0)
+type Progression struct {
+	Model      string
+	Thresholds []int
+}
+
+// 📐 Разбор кривой: exp:<XP за Level 2>:<множитель> или table:0,500,1250,...
+func ParseCurve(curve string, levels int) ([]int, error) {
+	kind, params, _ := strings.Cut(curve, ":")
+	thresholds := []int{0}
+
+	switch kind {
+	case "exp":
+		var base, factor float64
+		if _, err := fmt.Sscanf(params, "%g:%g", &base, &factor); err != nil {
+			return nil, err
+		}
+		if base <= 0 || factor < 1 {
+			return nil, fmt.Errorf("base > 0, factor >= 1")
+		}
+		step := base
+		for level := 2; level <= levels; level++ {
+			thresholds = append(thresholds, thresholds[len(thresholds)-1]+int(math.Round(step)))
+			step *= factor
+		}
+
+	case "table":
+		thresholds = nil
+		for _, field := range strings.Split(params, ",") {
+			xp, err := strconv.Atoi(strings.TrimSpace(field))
+			if err != nil {
+				return nil, err
+			}
+			if len(thresholds) > 0 && xp < thresholds[len(thresholds)-1] {
+				return nil, fmt.Errorf("%d < %d", xp, thresholds[len(thresholds)-1])
+			}
+			thresholds = append(thresholds, xp)
+		}
+		if len(thresholds) == 0 || thresholds[0] != 0 {
+			return nil, fmt.Errorf("table must start with 0")
+		}
+		if len(thresholds) > levels {
+			thresholds = thresholds[:levels]
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown curve %q", kind)
+	}
+
+	return thresholds, nil
+}
+
+// 🏆 Уровень по модели (topics — с результатами последнего анализа)
+func (p Progression) Level(stats store.UserStats, topics []analyzer.Topic) int {
+	switch p.Model {
+	case ModelXP:
+		level := 1
+		for i, xp := range p.Thresholds {
+			if stats.TotalXP >= xp {
+				level = i + 1
+			}
+		}
+		return level
+
+	case ModelHighest:
+		level := 1
+		for _, topic := range topics {
+			if topic.Completed() && topic.Level > level {
+				level = topic.Level
+			}
+		}
+		return level
+	}
+
+	// strict: уровень с изученной темой, все темы ниже которого изучены
+	level := 1
+	for next := 2; next <= analyzer.MaxLevel(topics); next++ {
+		if !levelCompleted(topics, next-1) || !levelStarted(topics, next) {
+			break
+		}
+		level = next
+	}
+	return level
+}
+
+// ✅ Все темы уровня изучены
+func levelCompleted(topics []analyzer.Topic, level int) bool {
+	for _, topic := range topics {
+		if topic.Level == level && !topic.Completed() {
+			return false
+		}
+	}
+	return true
+}
+
+// 🚩 Есть хотя бы одна изученная тема уровня
+func levelStarted(topics []analyzer.Topic, level int) bool {
+	for _, topic := range topics {
+		if topic.Level == level && topic.Completed() {
+			return true
+		}
+	}
+	return false
+}
+
+// ⏭️ Путь до следующего уровня
+func (p Progression) Next(stats store.UserStats, topics []analyzer.Topic) LevelProgress {
+	progress := LevelProgress{Model: p.Model}
+	if stats.Level < 1 || stats.Level >= analyzer.MaxLevel(topics) {
+		return progress
+	}
+	progress.Next = stats.Level + 1
+
+	if progress.Model == ModelXP {
+		if progress.Next > len(p.Thresholds) {
+			progress.Next = 0
+			return progress
+		}
+		from, to := p.Thresholds[stats.Level-1], p.Thresholds[progress.Next-1]
+		progress.XPToNext = to - stats.TotalXP
+		if progress.XPToNext < 0 {
+			progress.XPToNext = 0
+		}
+		if to > from {
+			progress.Percent = float64(stats.TotalXP-from) / float64(to-from) * 100
+		}
+		return progress
+	}
+
+	// По темам: в strict нужны все темы уровней 1..Level, в highest — любая
+	// тема следующего уровня; плюс самая дешёвая тема следующего уровня
+	required, done := 0, 0
+	cheapest := 0
+	for _, topic := range topics {
+		switch {
+		case topic.Level <= stats.Level && progress.Model == ModelStrict:
+			required++
+			if topic.Completed() {
+				done++
+			} else {
+				progress.TopicsToNext++
+				progress.XPToNext += topic.XPReward
+			}
+		case topic.Level == progress.Next && !topic.Completed():
+			if cheapest == 0 || topic.XPReward < cheapest {
+				cheapest = topic.XPReward
+			}
+		}
+	}
+	if !levelStarted(topics, progress.Next) {
+		progress.TopicsToNext++
+		progress.XPToNext += cheapest
+		required++
+	} else {
+		done++
+		required++
+	}
+	progress.Percent = float64(done) / float64(required) * 100
+
+	return progress
+}
@@ -0,0 +1,89 @@
+package progress
+
+import (
+	"testing"
+
+	"github.com/yourusername/go-learning-tracker/tracker/analyzer"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+func TestParseCurve(t *testing.T) {
+	thresholds, err := ParseCurve(DefaultCurve, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []int{0, 500, 1250, 2375, 4063}
+	for i := range want {
+		if thresholds[i] != want[i] {
+			t.Fatalf("exp = %v, want %v", thresholds, want)
+		}
+	}
+
+	thresholds, err = ParseCurve("table:0, 100,300,600", 3)
+	if err != nil || len(thresholds) != 3 || thresholds[2] != 300 {
+		t.Errorf("table = %v, %v", thresholds, err)
+	}
+
+	for _, curve := range []string{"exp:0:2", "exp:100:0.5", "table:100,200", "table:0,200,100", "table:0,x", "log:1"} {
+		if _, err := ParseCurve(curve, 12); err == nil {
+			t.Errorf("ParseCurve(%q): want error", curve)
+		}
+	}
+}
+
+// 📚 Программа с изученными темами (остальные не начаты)
+func syllabusWith(ids ...string) []analyzer.Topic {
+	topics := analyzer.DefaultSyllabus()
+	for i := range topics {
+		for _, id := range ids {
+			if topics[i].ID == id {
+				topics[i].Found = topics[i].MinExamples
+			}
+		}
+	}
+	return topics
+}
+
+func TestProgressionLevel(t *testing.T) {
+	thresholds, _ := ParseCurve(DefaultCurve, 12)
+	// Level 1 изучен целиком, из Level 2 — только switch, плюс одна тема Level 4
+	topics := syllabusWith("types", "variables", "switch", "functions")
+
+	tests := []struct {
+		model string
+		xp    int
+		want  int
+	}{
+		{ModelStrict, 0, 2},
+		{ModelHighest, 0, 4},
+		{ModelXP, 1249, 2},
+		{ModelXP, 1250, 3},
+	}
+	for _, tt := range tests {
+		p := Progression{Model: tt.model, Thresholds: thresholds}
+		if got := p.Level(store.UserStats{TotalXP: tt.xp}, topics); got != tt.want {
+			t.Errorf("%s, %d XP: Level = %d, want %d", tt.model, tt.xp, got, tt.want)
+		}
+	}
+}
+
+func TestProgressionNext(t *testing.T) {
+	thresholds, _ := ParseCurve(DefaultCurve, 12)
+	topics := syllabusWith("types", "variables", "switch")
+
+	strict := Progression{Model: ModelStrict, Thresholds: thresholds}.Next(store.UserStats{Level: 2}, topics)
+	// До Level 3: conditions и loops (по 75 XP) и любая тема Level 3 (100 XP)
+	if strict.Next != 3 || strict.TopicsToNext != 3 || strict.XPToNext != 250 {
+		t.Errorf("strict = %+v", strict)
+	}
+
+	xp := Progression{Model: ModelXP, Thresholds: thresholds}.Next(store.UserStats{Level: 2, TotalXP: 875}, topics)
+	if xp.Next != 3 || xp.XPToNext != 375 || xp.Percent != 50 {
+		t.Errorf("xp = %+v", xp)
+	}
+
+	last := Progression{Model: ModelXP, Thresholds: thresholds}.Next(store.UserStats{Level: 12}, topics)
+	if last.Next != 0 {
+		t.Errorf("max level: Next = %d, want 0", last.Next)
+	}
+}
@@ -0,0 +1,207 @@
+package progress
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+// 🏁 СЕЗОНЫ
+// Лига не зависит от суммарного XP: в конце сезона (месяц или квартал)
+// лучшие в своей лиге поднимаются, отстающие опускаются, а сезонный XP
+// обнуляется
+const (
+	SeasonMonth   = "month"
+	SeasonQuarter = "quarter"
+)
+
+// Значения по умолчанию для Rules (меняются из tracker.json)
+const (
+	SeasonMoveShare = 5 // Поднимается и опускается по 1/5 лиги (минимум один)
+
+	// Без соперников (нет leaderboard и команды) — пороги сезонного XP за месяц
+	SeasonPromoteXP = 2000
+	SeasonKeepXP    = 300
+
+	// Награды за итог сезона
+	SeasonRewardPromoted = 150
+	SeasonRewardStayed   = 50
+	SeasonRewardChampion = 100 // Дополнительно за первое место в лиге
+)
+
+const (
+	SeasonPromoted  = "promoted"
+	SeasonRelegated = "relegated"
+	SeasonStayed    = "stayed"
+)
+
+// 🏅 Лиги от младшей к старшей
+func Leagues() []string {
+	return []string{"🥉 Bronze", "🥈 Silver", "🥇 Gold", "💎 Diamond"}
+}
+
+// 🏷️ Название лиги без эмодзи ("🥇 Gold" → "Gold")
+func LeagueTitle(league string) string {
+	fields := strings.Fields(league)
+	if len(fields) == 0 {
+		return league
+	}
+	return fields[len(fields)-1]
+}
+
+// 🔢 Номер лиги в Leagues (-1 — неизвестная)
+func LeagueIndex(league string) int {
+	for i, name := range Leagues() {
+		if LeagueTitle(name) == LeagueTitle(league) {
+			return i
+		}
+	}
+	return -1
+}
+
+// 🏆 Стартовая лига (в первом сезоне; дальше — повышение и понижение)
+// Пороги по умолчанию рассчитаны на полный пул: 5625 XP за темы 12 уровней + достижения
+func DetermineLeague(level, xp int, rules Rules) string {
+	leagues := Leagues()
+	for i := len(rules.LeagueLevels) - 1; i >= 0; i-- {
+		if level >= rules.LeagueLevels[i] || xp >= rules.LeagueXP[i] {
+			return leagues[i+1]
+		}
+	}
+	return leagues[0]
+}
+
+// 🏷️ ID сезона: 2026-10 или 2026-Q4
+func SeasonID(now time.Time, length string) string {
+	if length == SeasonQuarter {
+		return fmt.Sprintf("%d-Q%d", now.Year(), (int(now.Month())-1)/3+1)
+	}
+	return now.Format("2006-01")
+}
+
+// 🧑‍🎓 Участник сезона
+type Standing struct {
+	Username string
+	League   string
+	SeasonXP int
+}
+
+// 🔄 Смена сезона: подводим итог прошлого, начинаем новый
+// standings — участники завершившегося сезона (вызывается только при смене)
+// Возвращает итог завершённого сезона (nil — сезон не менялся)
+func RolloverSeason(stats *store.UserStats, now time.Time, length string, rules Rules, standings func(season string) []Standing) *store.SeasonResult {
+	current := SeasonID(now, length)
+	if stats.Season == current {
+		return nil
+	}
+
+	// Первый сезон: стартовая лига по уровню и XP
+	if stats.Season == "" {
+		stats.League = DetermineLeague(stats.Level, stats.TotalXP, rules)
+		stats.Season = current
+		stats.SeasonXP = 0
+		return nil
+	}
+
+	result := FinishSeason(*stats, standings(stats.Season), length, rules)
+	stats.Seasons = append(stats.Seasons, result)
+	stats.League = result.NewLeague
+	stats.TotalXP += result.Reward
+	stats.Season = current
+	stats.SeasonXP = 0
+	return &result
+}
+
+// 🏆 Итог сезона по месту в своей лиге
+// rows — участники этого сезона (в т.ч. сам ученик), может быть пустым
+func FinishSeason(stats store.UserStats, rows []Standing, length string, rules Rules) store.SeasonResult {
+	result := store.SeasonResult{ID: stats.Season, XP: stats.SeasonXP, League: stats.League}
+
+	players, better := 1, 0
+	for _, row := range rows {
+		if row.Username == stats.Username || LeagueTitle(row.League) != LeagueTitle(stats.League) {
+			continue
+		}
+		players++
+		if row.SeasonXP > stats.SeasonXP {
+			better++
+		}
+	}
+
+	move := 0
+	if players > 1 {
+		result.Rank, result.Players = better+1, players
+		quota := players / rules.SeasonMoveShare
+		if quota < 1 {
+			quota = 1
+		}
+		switch {
+		case stats.SeasonXP > 0 && result.Rank <= quota:
+			move = 1
+		case stats.SeasonXP <= 0 || result.Rank > players-quota:
+			move = -1
+		}
+	} else {
+		scale := 1
+		if length == SeasonQuarter {
+			scale = 3
+		}
+		switch {
+		case stats.SeasonXP >= rules.SeasonPromoteXP*scale:
+			move = 1
+		case stats.SeasonXP < rules.SeasonKeepXP*scale:
+			move = -1
+		}
+	}
+
+	leagues := Leagues()
+	index := LeagueIndex(stats.League)
+	if index < 0 {
+		index = 0
+	}
+	next := index + move
+	if next < 0 {
+		next = 0
+	}
+	if next >= len(leagues) {
+		next = len(leagues) - 1
+	}
+	result.NewLeague = leagues[next]
+
+	switch {
+	case next > index:
+		result.Outcome = SeasonPromoted
+		result.Reward = rules.SeasonRewardPromoted
+	case next < index:
+		result.Outcome = SeasonRelegated
+	default:
+		result.Outcome = SeasonStayed
+		if stats.SeasonXP > 0 {
+			result.Reward = rules.SeasonRewardStayed
+		}
+	}
+	if result.Rank == 1 && stats.SeasonXP > 0 {
+		result.Reward += rules.SeasonRewardChampion
+	}
+
+	return result
+}
+
+// 🧑‍🎓 Результат ученика в сезоне: текущий или уже подведённый
+func SeasonStanding(stats store.UserStats, season string) (Standing, bool) {
+	row := Standing{Username: stats.Username, League: stats.League}
+	if stats.Season == season {
+		row.SeasonXP = stats.SeasonXP
+		return row, true
+	}
+	for _, result := range stats.Seasons {
+		if result.ID == season {
+			row.League = result.League
+			row.SeasonXP = result.XP
+			return row, true
+		}
+	}
+	return row, false
+}
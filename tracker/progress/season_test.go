@@ -0,0 +1,149 @@
+package progress
+
+import (
+	"testing"
+
+	"github.com/yourusername/go-learning-tracker/tracker/clock"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+func TestSeasonID(t *testing.T) {
+	tests := []struct {
+		date, length, want string
+	}{
+		{"2026-01-31", SeasonMonth, "2026-01"},
+		{"2026-02-01", SeasonMonth, "2026-02"},
+		{"2026-03-31", SeasonQuarter, "2026-Q1"},
+		{"2026-04-01", SeasonQuarter, "2026-Q2"},
+		{"2026-12-31", SeasonQuarter, "2026-Q4"},
+	}
+	for _, tt := range tests {
+		if got := SeasonID(clock.At(tt.date).Now(), tt.length); got != tt.want {
+			t.Errorf("SeasonID(%s, %s) = %q, want %q", tt.date, tt.length, got, tt.want)
+		}
+	}
+}
+
+func TestFinishSeason(t *testing.T) {
+	tests := []struct {
+		name        string
+		league      string
+		seasonXP    int
+		rows        []Standing
+		length      string
+		wantOutcome string
+		wantLeague  string
+		wantReward  int
+		wantRank    int
+	}{
+		{"без соперников: повышение", "🥉 Bronze", 2000, nil, SeasonMonth, SeasonPromoted, "🥈 Silver", 150, 0},
+		{"без соперников: остался", "🥈 Silver", 300, nil, SeasonMonth, SeasonStayed, "🥈 Silver", 50, 0},
+		{"без соперников: понижение", "🥈 Silver", 299, nil, SeasonMonth, SeasonRelegated, "🥉 Bronze", 0, 0},
+		{"квартал втрое дороже", "🥉 Bronze", 2000, nil, SeasonQuarter, SeasonStayed, "🥉 Bronze", 50, 0},
+		{"ниже Bronze некуда", "🥉 Bronze", 0, nil, SeasonMonth, SeasonStayed, "🥉 Bronze", 0, 0},
+		{"выше Diamond некуда", "💎 Diamond", 9000, nil, SeasonMonth, SeasonStayed, "💎 Diamond", 50, 0},
+		{
+			"первое место в лиге", "🥇 Gold", 800,
+			[]Standing{{"bob", "🥇 Gold", 500}, {"eve", "🥇 Gold", 100}, {"max", "🥈 Silver", 5000}},
+			SeasonMonth, SeasonPromoted, "💎 Diamond", 250, 1,
+		},
+		{
+			"последнее место в лиге", "🥇 Gold", 50,
+			[]Standing{{"bob", "🥇 Gold", 500}, {"eve", "🥇 Gold", 100}},
+			SeasonMonth, SeasonRelegated, "🥈 Silver", 0, 3,
+		},
+		{
+			"середина", "🥇 Gold", 300,
+			[]Standing{{"bob", "🥇 Gold", 500}, {"eve", "🥇 Gold", 100}},
+			SeasonMonth, SeasonStayed, "🥇 Gold", 50, 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := store.UserStats{Username: "alice", League: tt.league, Season: "2026-01", SeasonXP: tt.seasonXP}
+			rows := append([]Standing{{"alice", tt.league, tt.seasonXP}}, tt.rows...)
+
+			result := FinishSeason(stats, rows, tt.length, DefaultRules())
+
+			if result.Outcome != tt.wantOutcome || result.NewLeague != tt.wantLeague ||
+				result.Reward != tt.wantReward || result.Rank != tt.wantRank {
+				t.Errorf("result = %+v, want %s → %s, reward %d, rank %d",
+					result, tt.wantOutcome, tt.wantLeague, tt.wantReward, tt.wantRank)
+			}
+		})
+	}
+}
+
+// ⚙️ Пороги и награды сезона берутся из правил
+func TestFinishSeasonRules(t *testing.T) {
+	rules := DefaultRules()
+	rules.SeasonPromoteXP, rules.SeasonRewardPromoted, rules.SeasonRewardChampion = 500, 70, 30
+
+	stats := store.UserStats{Username: "alice", League: "🥉 Bronze", Season: "2026-01", SeasonXP: 600}
+	if result := FinishSeason(stats, nil, SeasonMonth, rules); result.Outcome != SeasonPromoted || result.Reward != 70 {
+		t.Errorf("no rivals: %+v", result)
+	}
+
+	rows := []Standing{{"alice", "🥉 Bronze", 600}, {"bob", "🥉 Bronze", 100}}
+	if result := FinishSeason(stats, rows, SeasonMonth, rules); result.Rank != 1 || result.Reward != 100 {
+		t.Errorf("champion: %+v", result)
+	}
+}
+
+func TestRolloverSeason(t *testing.T) {
+	stats := store.UserStats{Username: "alice", Level: 4, TotalXP: 1000}
+	noRivals := func(string) []Standing { return nil }
+
+	// Первый сезон: стартовая лига, без итога
+	if result := RolloverSeason(&stats, clock.At("2026-01-15").Now(), SeasonMonth, DefaultRules(), noRivals); result != nil {
+		t.Fatalf("first season: result = %+v", result)
+	}
+	if stats.Season != "2026-01" || stats.League != "🥈 Silver" {
+		t.Fatalf("first season: %s %s", stats.Season, stats.League)
+	}
+
+	// Тот же месяц — ничего не меняется
+	stats.SeasonXP = 2500
+	if result := RolloverSeason(&stats, clock.At("2026-01-31").Now(), SeasonMonth, DefaultRules(), noRivals); result != nil {
+		t.Fatalf("same season: result = %+v", result)
+	}
+
+	// 1 февраля: итог января, награда, новый сезон с нуля
+	result := RolloverSeason(&stats, clock.At("2026-02-01").Now(), SeasonMonth, DefaultRules(), noRivals)
+	if result == nil || result.ID != "2026-01" || result.Outcome != SeasonPromoted {
+		t.Fatalf("rollover: result = %+v", result)
+	}
+	if stats.Season != "2026-02" || stats.SeasonXP != 0 || stats.League != "🥇 Gold" || stats.TotalXP != 1150 {
+		t.Errorf("after rollover: %+v", stats)
+	}
+	if standing, ok := SeasonStanding(stats, "2026-01"); !ok || standing.SeasonXP != 2500 || standing.League != "🥈 Silver" {
+		t.Errorf("SeasonStanding = %+v, %v", standing, ok)
+	}
+}
+
+func TestLeagues(t *testing.T) {
+	if LeagueTitle("🥇 Gold") != "Gold" || LeagueIndex("Gold") != 2 || LeagueIndex("Wood") != -1 {
+		t.Errorf("LeagueTitle/LeagueIndex")
+	}
+	tests := []struct {
+		level, xp int
+		want      string
+	}{
+		{1, 0, "🥉 Bronze"},
+		{4, 0, "🥈 Silver"},
+		{1, 4000, "🥇 Gold"},
+		{10, 0, "💎 Diamond"},
+	}
+	for _, tt := range tests {
+		if got := DetermineLeague(tt.level, tt.xp, DefaultRules()); got != tt.want {
+			t.Errorf("DetermineLeague(%d, %d) = %q, want %q", tt.level, tt.xp, got, tt.want)
+		}
+	}
+	// Пороги из tracker.json
+	rules := DefaultRules()
+	rules.LeagueLevels = []int{2, 3, 4}
+	if got := DetermineLeague(3, 0, rules); got != "🥇 Gold" {
+		t.Errorf("DetermineLeague with league_levels %v = %q", rules.LeagueLevels, got)
+	}
+}
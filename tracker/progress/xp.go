@@ -0,0 +1,175 @@
+// Package progress считает XP, streak, штрафы, уровни и сезоны.
+// Все функции получают время явно и не хранят состояния между вызовами.
+package progress
+
+import (
+	"time"
+
+	"github.com/yourusername/go-learning-tracker/tracker/achievements"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+const (
+	PenaltyPerDay     = 30 // XP за каждый пропущенный день
+	StreakBonusPerDay = 20 // XP за каждый день серии
+)
+
+// ⚖️ Правила начисления XP и лиг (меняются из tracker.json, simulate примеряет новые)
+type Rules struct {
+	PenaltyPerDay     int
+	StreakBonusPerDay int
+	LeagueLevels      []int // Стартовая лига: пороги уровня для Silver, Gold и Diamond
+	LeagueXP          []int // ... или пороги XP
+	Achievements      achievements.Rules
+
+	// Сезоны: доля лиги на повышение и понижение, пороги без соперников, награды
+	SeasonMoveShare      int
+	SeasonPromoteXP      int
+	SeasonKeepXP         int
+	SeasonRewardPromoted int
+	SeasonRewardStayed   int
+	SeasonRewardChampion int
+
+	FreezesPerMonth int // Заморозок streak в месяц
+}
+
+// 📦 Правила по умолчанию (каждый вызов — новая копия)
+func DefaultRules() Rules {
+	return Rules{
+		PenaltyPerDay:     PenaltyPerDay,
+		StreakBonusPerDay: StreakBonusPerDay,
+		LeagueLevels:      []int{4, 7, 10},
+		LeagueXP:          []int{2000, 4000, 6500},
+		Achievements:      achievements.DefaultRules(),
+
+		SeasonMoveShare:      SeasonMoveShare,
+		SeasonPromoteXP:      SeasonPromoteXP,
+		SeasonKeepXP:         SeasonKeepXP,
+		SeasonRewardPromoted: SeasonRewardPromoted,
+		SeasonRewardStayed:   SeasonRewardStayed,
+		SeasonRewardChampion: SeasonRewardChampion,
+
+		FreezesPerMonth: FreezesPerMonth,
+	}
+}
+
+// 💰 XP за запуск по источникам
+type XPBreakdown struct {
+	Topics       int      // За новые темы
+	NewTopics    []string // Названия новых тем
+	Streak       int      // Бонус за streak
+	Achievements int      // За новые достижения
+	Penalty      int      // Штраф за пропуски (уже вычтен из TotalXP)
+	Season       int      // Награда за итог прошлого сезона
+}
+
+// ➕ XP за темы и streak (показывается рядом с уровнем)
+func (x XPBreakdown) Gained() int {
+	return x.Topics + x.Streak
+}
+
+// 🧮 Итог запуска с учётом достижений, награды за сезон и штрафа
+func (x XPBreakdown) Net() int {
+	return x.Topics + x.Streak + x.Achievements + x.Season - x.Penalty
+}
+
+// 🔥 Бонус за текущую серию
+func StreakBonus(stats store.UserStats, rules Rules) int {
+	return stats.CurrentStreak * rules.StreakBonusPerDay
+}
+
+// 📅 Полных дней между датой из статистики и now
+func daysSince(date string, now time.Time) (time.Time, int) {
+	last, _ := time.Parse("2006-01-02", date)
+	return last, int(now.Sub(last).Hours() / 24)
+}
+
+// ⚠️ Штраф за пропуски: PenaltyDays и TotalXP (не ниже нуля)
+// Возвращает начисленный штраф в XP (0 — пропусков нет)
+func ApplyPenalties(stats *store.UserStats, now time.Time, rules Rules) int {
+	if stats.LastCommitDate == "" {
+		return 0
+	}
+
+	lastDate, days := daysSince(stats.LastCommitDate, now)
+
+	// Замороженные дни не штрафуются
+	missed := 0
+	if days > 1 {
+		missed = days - 1 - FrozenDaysBetween(*stats, lastDate, days)
+	}
+
+	if missed <= 0 {
+		stats.PenaltyDays = 0
+		return 0
+	}
+
+	stats.PenaltyDays = missed
+	penalty := stats.PenaltyDays * rules.PenaltyPerDay
+	stats.TotalXP -= penalty
+	if stats.TotalXP < 0 {
+		stats.TotalXP = 0
+	}
+	return penalty
+}
+
+// 🔥 Обновление streak
+func UpdateStreak(stats *store.UserStats, now time.Time) {
+	if stats.LastCommitDate == "" {
+		stats.CurrentStreak = 1
+		stats.LongestStreak = 1
+	} else {
+		lastDate, days := daysSince(stats.LastCommitDate, now)
+
+		// Пропуск, целиком покрытый заморозками, серию не прерывает
+		if days == 1 || (days > 1 && FrozenDaysBetween(*stats, lastDate, days) == days-1) {
+			stats.CurrentStreak++
+			if stats.CurrentStreak > stats.LongestStreak {
+				stats.LongestStreak = stats.CurrentStreak
+			}
+		} else if days > 1 {
+			stats.CurrentStreak = 1
+		}
+	}
+
+	stats.LastCommitDate = now.Format("2006-01-02")
+}
+
+// 📈 Запись точки истории (повторный запуск в тот же день обновляет точку)
+// Несколько запусков за день складываются в одну точку
+func RecordHistory(stats *store.UserStats, xp XPBreakdown, newTopics []string, newAchievements []achievements.Achievement) {
+	point := store.HistoryPoint{
+		Date:            stats.LastCommitDate,
+		TotalXP:         stats.TotalXP,
+		Level:           stats.Level,
+		CompletedTopics: stats.CompletedTopics,
+		Runs:            1,
+		XPTopics:        xp.Topics,
+		XPStreak:        xp.Streak,
+		XPAchievements:  xp.Achievements,
+		XPPenalty:       xp.Penalty,
+		XPSeason:        xp.Season,
+		NewTopics:       newTopics,
+	}
+	for _, ach := range newAchievements {
+		point.NewAchievements = append(point.NewAchievements, ach.ID)
+	}
+
+	n := len(stats.History)
+	if n == 0 || stats.History[n-1].Date != point.Date {
+		stats.History = append(stats.History, point)
+		return
+	}
+
+	prev := stats.History[n-1]
+	point.Runs += prev.Runs
+	point.XPTopics += prev.XPTopics
+	point.XPStreak += prev.XPStreak
+	point.XPAchievements += prev.XPAchievements
+	point.XPPenalty += prev.XPPenalty
+	point.XPSeason += prev.XPSeason
+	point.NewTopics = append(prev.NewTopics, point.NewTopics...)
+	point.NewAchievements = append(prev.NewAchievements, point.NewAchievements...)
+	point.Position = prev.Position
+	stats.History[n-1] = point
+}
@@ -0,0 +1,151 @@
+package progress
+
+import (
+	"testing"
+
+	"github.com/yourusername/go-learning-tracker/tracker/achievements"
+	"github.com/yourusername/go-learning-tracker/tracker/clock"
+	"github.com/yourusername/go-learning-tracker/tracker/store"
+)
+
+func TestUpdateStreak(t *testing.T) {
+	tests := []struct {
+		name        string
+		last        string
+		frozen      []string
+		today       string
+		streak      int
+		wantStreak  int
+		wantLongest int
+	}{
+		{"первый запуск", "", nil, "2026-03-10", 0, 1, 1},
+		{"тот же день", "2026-03-10", nil, "2026-03-10", 4, 4, 9},
+		{"следующий день", "2026-03-10", nil, "2026-03-11", 4, 5, 9},
+		{"через границу месяца", "2026-01-31", nil, "2026-02-01", 8, 9, 9},
+		{"конец февраля", "2026-02-28", nil, "2026-03-01", 9, 10, 10},
+		{"високосный февраль", "2028-02-28", nil, "2028-02-29", 2, 3, 9},
+		{"через новый год", "2025-12-31", nil, "2026-01-01", 3, 4, 9},
+		{"пропуск на границе месяца", "2026-01-30", nil, "2026-02-01", 8, 1, 9},
+		{"пропуск покрыт заморозкой", "2026-01-30", []string{"2026-01-31"}, "2026-02-01", 8, 9, 9},
+		{"заморозка покрывает не весь пропуск", "2026-01-29", []string{"2026-01-31"}, "2026-02-01", 8, 1, 9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := store.UserStats{
+				LastCommitDate: tt.last,
+				CurrentStreak:  tt.streak,
+				LongestStreak:  9,
+				FrozenDays:     tt.frozen,
+			}
+			if tt.last == "" {
+				stats.LongestStreak = 0
+			}
+
+			UpdateStreak(&stats, clock.At(tt.today).Now())
+
+			if stats.CurrentStreak != tt.wantStreak || stats.LongestStreak != tt.wantLongest {
+				t.Errorf("streak = %d/%d, want %d/%d", stats.CurrentStreak, stats.LongestStreak, tt.wantStreak, tt.wantLongest)
+			}
+			if stats.LastCommitDate != tt.today {
+				t.Errorf("LastCommitDate = %q, want %q", stats.LastCommitDate, tt.today)
+			}
+		})
+	}
+}
+
+// 📅 Ежедневные коммиты с января по март: серия не рвётся на границах месяцев
+func TestUpdateStreakDaily(t *testing.T) {
+	stats := store.UserStats{}
+	day := clock.At("2026-01-25").Now()
+	for i := 0; i < 40; i++ {
+		UpdateStreak(&stats, day.AddDate(0, 0, i))
+	}
+	if stats.CurrentStreak != 40 || stats.LongestStreak != 40 {
+		t.Fatalf("streak = %d/%d, want 40/40", stats.CurrentStreak, stats.LongestStreak)
+	}
+	if stats.LastCommitDate != "2026-03-05" {
+		t.Fatalf("LastCommitDate = %q", stats.LastCommitDate)
+	}
+}
+
+func TestApplyPenalties(t *testing.T) {
+	tests := []struct {
+		name        string
+		last        string
+		frozen      []string
+		today       string
+		xp          int
+		wantPenalty int
+		wantXP      int
+		wantDays    int
+	}{
+		{"новый ученик", "", nil, "2026-03-10", 100, 0, 100, 0},
+		{"вчера", "2026-02-28", nil, "2026-03-01", 100, 0, 100, 0},
+		{"два пропуска через границу месяца", "2026-01-30", nil, "2026-02-02", 500, 60, 440, 2},
+		{"пропуск с заморозкой", "2026-01-30", []string{"2026-01-31"}, "2026-02-02", 500, 30, 470, 1},
+		{"всё заморожено", "2026-01-30", []string{"2026-01-31", "2026-02-01"}, "2026-02-02", 500, 0, 500, 0},
+		{"не ниже нуля", "2026-01-01", nil, "2026-02-01", 100, 900, 0, 30},
+		{"ноль остаётся нулём", "2026-01-01", nil, "2026-01-05", 0, 90, 0, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := store.UserStats{LastCommitDate: tt.last, TotalXP: tt.xp, FrozenDays: tt.frozen, PenaltyDays: 7}
+			if tt.last == "" {
+				stats.PenaltyDays = 0
+			}
+
+			penalty := ApplyPenalties(&stats, clock.At(tt.today).Now(), DefaultRules())
+
+			if penalty != tt.wantPenalty {
+				t.Errorf("penalty = %d, want %d", penalty, tt.wantPenalty)
+			}
+			if stats.TotalXP != tt.wantXP {
+				t.Errorf("TotalXP = %d, want %d", stats.TotalXP, tt.wantXP)
+			}
+			if stats.PenaltyDays != tt.wantDays {
+				t.Errorf("PenaltyDays = %d, want %d", stats.PenaltyDays, tt.wantDays)
+			}
+		})
+	}
+}
+
+func TestRecordHistory(t *testing.T) {
+	stats := store.UserStats{LastCommitDate: "2026-01-31", TotalXP: 100}
+	RecordHistory(&stats, XPBreakdown{Topics: 50, Streak: 20}, []string{"types"}, nil)
+
+	// Второй запуск в тот же день складывается с первым
+	stats.TotalXP = 400
+	RecordHistory(&stats, XPBreakdown{Achievements: 100, Penalty: 30}, []string{"variables"},
+		[]achievements.Achievement{{ID: "first_commit"}})
+
+	if len(stats.History) != 1 {
+		t.Fatalf("len(History) = %d, want 1", len(stats.History))
+	}
+	point := stats.History[0]
+	if point.Runs != 2 || point.TotalXP != 400 || point.XPTopics != 50 || point.XPStreak != 20 ||
+		point.XPAchievements != 100 || point.XPPenalty != 30 {
+		t.Errorf("point = %+v", point)
+	}
+	if len(point.NewTopics) != 2 || len(point.NewAchievements) != 1 {
+		t.Errorf("NewTopics = %v, NewAchievements = %v", point.NewTopics, point.NewAchievements)
+	}
+
+	// Новый день — новая точка
+	stats.LastCommitDate = "2026-02-01"
+	RecordHistory(&stats, XPBreakdown{}, nil, nil)
+	if len(stats.History) != 2 || stats.History[1].Date != "2026-02-01" {
+		t.Fatalf("History = %+v", stats.History)
+	}
+}
+
+func TestXPBreakdown(t *testing.T) {
+	xp := XPBreakdown{Topics: 100, Streak: 40, Achievements: 300, Penalty: 60, Season: 50}
+	if xp.Gained() != 140 {
+		t.Errorf("Gained = %d, want 140", xp.Gained())
+	}
+	if xp.Net() != 430 {
+		t.Errorf("Net = %d, want 430", xp.Net())
+	}
+}
@@ -0,0 +1,64 @@
+package store
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing/fstest"
+)
+
+// 💾 Файлы трекера: чтение через fs.FS, плюс запись
+// Пути — как в fs.FS: через "/", относительно корня репозитория
+type FS interface {
+	fs.FS
+	WriteFile(name string, data []byte, perm fs.FileMode) error
+	MkdirAll(name string, perm fs.FileMode) error
+}
+
+// 📁 Каталог на диске
+func DirFS(root string) FS {
+	return dirFS{FS: os.DirFS(root), root: root}
+}
+
+type dirFS struct {
+	fs.FS
+	root string
+}
+
+func (d dirFS) path(name string) string {
+	return filepath.Join(d.root, filepath.FromSlash(name))
+}
+
+func (d dirFS) WriteFile(name string, data []byte, perm fs.FileMode) error {
+	if !fs.ValidPath(name) {
+		return &fs.PathError{Op: "write", Path: name, Err: fs.ErrInvalid}
+	}
+	return os.WriteFile(d.path(name), data, perm)
+}
+
+func (d dirFS) MkdirAll(name string, perm fs.FileMode) error {
+	if !fs.ValidPath(name) {
+		return &fs.PathError{Op: "mkdir", Path: name, Err: fs.ErrInvalid}
+	}
+	return os.MkdirAll(d.path(name), perm)
+}
+
+// 🧪 Файлы в памяти (тесты, симуляция): ключ — путь, значение — содержимое
+type MemFS fstest.MapFS
+
+func (m MemFS) Open(name string) (fs.File, error) {
+	return fstest.MapFS(m).Open(name)
+}
+
+func (m MemFS) WriteFile(name string, data []byte, perm fs.FileMode) error {
+	if !fs.ValidPath(name) {
+		return &fs.PathError{Op: "write", Path: name, Err: fs.ErrInvalid}
+	}
+	m[name] = &fstest.MapFile{Data: append([]byte(nil), data...), Mode: perm}
+	return nil
+}
+
+// Каталоги в MemFS появляются сами вместе с файлами
+func (m MemFS) MkdirAll(name string, perm fs.FileMode) error {
+	return nil
+}
@@ -0,0 +1,159 @@
+// Package store хранит статистику ученика: stats.json и список
+// изученных тем (.completed_topics) в каталоге ученика.
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+
+	"github.com/yourusername/go-learning-tracker/tracker/achievements"
+	"github.com/yourusername/go-learning-tracker/tracker/integrity"
+)
+
+const (
+	StatsFile     = "stats.json"
+	CompletedFile = ".completed_topics"
+)
+
+// 📊 СТАТИСТИКА ПОЛЬЗОВАТЕЛЯ
+type UserStats struct {
+	Username        string
+	TotalXP         int
+	CurrentStreak   int
+	LongestStreak   int
+	TotalCommits    int
+	Level           int
+	League          string
+	CompletedTopics int
+	LastCommitDate  string
+	Achievements    []achievements.Achievement
+	PenaltyDays     int              // Дни без коммитов
+	PassedKatas     []string         `json:",omitempty"` // Пройденные упражнения (kata mode)
+	History         []HistoryPoint   `json:",omitempty"` // XP по дням (для графика)
+	FrozenDays      []string         `json:",omitempty"` // Замороженные дни streak (/freeze)
+	SeenReviews     []string         `json:",omitempty"` // Подписи отказов, уже показанных в отчёте
+	Season          string           `json:",omitempty"` // Текущий сезон (2026-10)
+	SeasonXP        int              // XP за текущий сезон
+	Seasons         []SeasonResult   `json:",omitempty"` // Итоги прошлых сезонов
+	LastSeenCommit  string           `json:",omitempty"` // Последний проверенный коммит (integrity)
+	IntegrityFlags  []integrity.Flag `json:",omitempty"` // Подозрения в накрутке
+}
+
+// 📈 Точка истории прогресса (одна на день)
+type HistoryPoint struct {
+	Date            string
+	TotalXP         int
+	Level           int
+	CompletedTopics int
+	Runs            int      `json:",omitempty"` // Запусков трекера за день
+	XPTopics        int      `json:",omitempty"` // XP по источникам (для дайджеста)
+	XPStreak        int      `json:",omitempty"`
+	XPAchievements  int      `json:",omitempty"`
+	XPPenalty       int      `json:",omitempty"`
+	XPSeason        int      `json:",omitempty"` // Награда за итог сезона
+	NewTopics       []string `json:",omitempty"` // ID тем, изученных в этот день
+	NewAchievements []string `json:",omitempty"` // ID открытых достижений
+	Position        int      `json:",omitempty"` // Место в leaderboard на конец дня
+}
+
+// 📜 Итог сезона (UserStats.Seasons)
+type SeasonResult struct {
+	ID        string
+	XP        int
+	League    string // Лига в этом сезоне
+	Rank      int    `json:",omitempty"` // Место в лиге (0 — без соперников)
+	Players   int    `json:",omitempty"`
+	Outcome   string // promoted, relegated или stayed
+	NewLeague string
+	Reward    int
+}
+
+// 🆕 Статистика нового ученика
+func NewStats(username string) UserStats {
+	return UserStats{
+		Username:     username,
+		League:       "🥉 Bronze",
+		Achievements: []achievements.Achievement{},
+	}
+}
+
+// 📁 Каталог ученика в FS: "." — обычный режим, learners/<имя> — team mode
+type Store struct {
+	FS  FS
+	Dir string
+}
+
+func New(fsys FS, dir string) Store { return Store{FS: fsys, Dir: dir} }
+
+// 📄 Путь к файлу в каталоге ученика (относительно корня FS)
+func (s Store) Path(name string) string { return path.Join(s.Dir, name) }
+
+// 📊 Загрузка статистики (fs.ErrNotExist — ученик ещё не запускал трекер)
+func (s Store) LoadStats() (UserStats, error) {
+	data, err := fs.ReadFile(s.FS, s.Path(StatsFile))
+	if err != nil {
+		return UserStats{}, err
+	}
+
+	var stats UserStats
+	err = json.Unmarshal(data, &stats)
+	return stats, err
+}
+
+// 💾 Сохранение статистики
+func (s Store) SaveStats(stats UserStats) error {
+	data, err := json.MarshalIndent(stats, "", "  ")
+	if err != nil {
+		return err
+	}
+	return s.FS.WriteFile(s.Path(StatsFile), data, 0644)
+}
+
+// 📝 Темы, изученные к прошлому запуску (ID или, в старом формате, названия)
+func (s Store) LoadCompleted() []string {
+	data, err := fs.ReadFile(s.FS, s.Path(CompletedFile))
+	if err != nil {
+		return []string{}
+	}
+
+	var topics []string
+	json.Unmarshal(data, &topics)
+	return topics
+}
+
+// 🩺 Проверка файлов состояния перед запуском: нет файла — не ошибка,
+// нечитаемый JSON — ошибка (иначе запуск перезапишет его пустой статистикой)
+func (s Store) Check() error {
+	files := []struct {
+		name  string
+		value interface{}
+	}{
+		{StatsFile, &UserStats{}},
+		{CompletedFile, &[]string{}},
+	}
+	for _, file := range files {
+		data, err := fs.ReadFile(s.FS, s.Path(file.name))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err == nil {
+			err = json.Unmarshal(data, file.value)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", s.Path(file.name), err)
+		}
+	}
+	return nil
+}
+
+// 💾 Темы, изученные в этом запуске
+func (s Store) SaveCompleted(ids []string) error {
+	data, err := json.Marshal(ids)
+	if err != nil {
+		return err
+	}
+	return s.FS.WriteFile(s.Path(CompletedFile), data, 0644)
+}
@@ -0,0 +1,103 @@
+package store
+
+import (
+	"errors"
+	"io/fs"
+	"testing"
+)
+
+func TestStoreRoundTrip(t *testing.T) {
+	memfs := MemFS{}
+	s := New(memfs, "learners/alice")
+
+	if _, err := s.LoadStats(); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("empty store: err = %v, want fs.ErrNotExist", err)
+	}
+	if topics := s.LoadCompleted(); len(topics) != 0 {
+		t.Fatalf("empty store: completed = %v", topics)
+	}
+
+	stats := NewStats("alice")
+	stats.TotalXP = 420
+	stats.History = []HistoryPoint{{Date: "2026-01-31", TotalXP: 420, Runs: 2}}
+	if err := s.SaveStats(stats); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SaveCompleted([]string{"types", "variables"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := memfs["learners/alice/stats.json"]; !ok {
+		t.Fatalf("stats.json not written: %v", memfs)
+	}
+
+	loaded, err := s.LoadStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Username != "alice" || loaded.TotalXP != 420 || loaded.League != "🥉 Bronze" ||
+		len(loaded.History) != 1 || loaded.History[0].Runs != 2 {
+		t.Errorf("loaded = %+v", loaded)
+	}
+	if topics := s.LoadCompleted(); len(topics) != 2 || topics[1] != "variables" {
+		t.Errorf("completed = %v", topics)
+	}
+}
+
+func TestMemFSWriteCopies(t *testing.T) {
+	memfs := MemFS{}
+	data := []byte("v1")
+	memfs.WriteFile("a.txt", data, 0644)
+	data[1] = '2'
+
+	got, err := fs.ReadFile(memfs, "a.txt")
+	if err != nil || string(got) != "v1" {
+		t.Errorf("a.txt = %q, %v", got, err)
+	}
+	if err := memfs.WriteFile("../a.txt", data, 0644); err == nil {
+		t.Error("invalid path accepted")
+	}
+}
+
+func TestDirFS(t *testing.T) {
+	dir := t.TempDir()
+	fsys := DirFS(dir)
+
+	if err := fsys.MkdirAll("badges", 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := fsys.WriteFile("badges/xp.svg", []byte("<svg/>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := fs.ReadFile(fsys, "badges/xp.svg")
+	if err != nil || string(got) != "<svg/>" {
+		t.Errorf("xp.svg = %q, %v", got, err)
+	}
+	if err := fsys.WriteFile("/etc/passwd", nil, 0644); err == nil {
+		t.Error("absolute path accepted")
+	}
+}
+
+func TestStoreCheck(t *testing.T) {
+	memfs := MemFS{}
+	s := New(memfs, ".")
+	if err := s.Check(); err != nil {
+		t.Fatalf("empty store: %v", err)
+	}
+
+	s.SaveStats(NewStats("alice"))
+	s.SaveCompleted([]string{"types"})
+	if err := s.Check(); err != nil {
+		t.Fatalf("valid store: %v", err)
+	}
+
+	memfs.WriteFile(CompletedFile, []byte(`["types",`), 0644)
+	if err := s.Check(); err == nil {
+		t.Error("truncated .completed_topics: want error")
+	}
+	s.SaveCompleted(nil)
+	memfs.WriteFile(StatsFile, []byte(`{"TotalXP": "lots"}`), 0644)
+	if err := s.Check(); err == nil {
+		t.Error("stats.json with wrong types: want error")
+	}
+}